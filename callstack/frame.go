@@ -32,6 +32,12 @@ type Frame struct {
 	f runtime.Frame
 }
 
+// NewFrame wraps a [runtime.Frame]. This is useful for frames that don't come
+// from a PC, such as those parsed from a goroutine dump.
+func NewFrame(f runtime.Frame) Frame {
+	return Frame{f: f}
+}
+
 // PC gets the raw program counter
 func (frame Frame) PC() uintptr {
 	return frame.f.PC
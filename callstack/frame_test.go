@@ -1,19 +1,21 @@
-package callstack
+package callstack_test
 
 import (
+	"runtime"
 	"strings"
 	"testing"
 
+	"github.com/thatguystone/cog/callstack"
 	"github.com/thatguystone/cog/check"
 )
 
 const (
-	pkgPath  = "github.com/thatguystone/cog/callstack"
+	pkgPath  = "github.com/thatguystone/cog/callstack_test"
 	fileName = "frame_test.go"
 )
 
 func TestSelfFunc(t *testing.T) {
-	fr := Self().Frame()
+	fr := callstack.Self().Frame()
 
 	const funcName = "TestSelfFunc"
 	check.NotEqual(t, fr.PC(), uintptr(0))
@@ -39,7 +41,7 @@ func TestSelfMethod(t *testing.T) {
 }
 
 func TestPCZero(t *testing.T) {
-	var pc PC
+	var pc callstack.PC
 	fr := pc.Frame()
 	check.Equal(t, fr.PkgPath(), "???")
 	check.Equal(t, fr.Func(), "???")
@@ -49,15 +51,28 @@ func TestPCZero(t *testing.T) {
 	check.Equal(t, fr.Line(), 0)
 }
 
+func TestNewFrame(t *testing.T) {
+	fr := callstack.NewFrame(runtime.Frame{
+		Function: "example.com/pkg.(*T).Run",
+		File:     "/src/pkg/t.go",
+		Line:     12,
+	})
+	check.Equal(t, fr.PC(), uintptr(0))
+	check.Equal(t, fr.PkgPath(), "example.com/pkg")
+	check.Equal(t, fr.FuncName(), "(*T).Run")
+	check.Equal(t, fr.FileName(), "t.go")
+	check.Equal(t, fr.String(), "example.com/pkg.(*T).Run()\n\t/src/pkg/t.go:12\n")
+}
+
 func TestFrameString(t *testing.T) {
-	str := Self().Frame().String()
+	str := callstack.Self().Frame().String()
 	check.True(t, strings.Contains(str, fileName))
 }
 
 type testSelf struct{}
 
-func (testSelf) getPC() PC {
-	return Self()
+func (testSelf) getPC() callstack.PC {
+	return callstack.Self()
 }
 
 func BenchmarkSelf(b *testing.B) {
@@ -65,7 +80,7 @@ func BenchmarkSelf(b *testing.B) {
 
 	recurse(10, func() any {
 		for b.Loop() {
-			Self()
+			callstack.Self()
 		}
 
 		return nil
@@ -77,7 +92,7 @@ func BenchmarkSelfFrame(b *testing.B) {
 
 	recurse(10, func() any {
 		for b.Loop() {
-			Self().Frame()
+			callstack.Self().Frame()
 		}
 
 		return nil
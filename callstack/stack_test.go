@@ -1,4 +1,4 @@
-package callstack
+package callstack_test
 
 import (
 	"reflect"
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/thatguystone/cog/callstack"
 	"github.com/thatguystone/cog/check"
 )
 
@@ -24,21 +25,21 @@ func recurse[T any](n int, cb func() T) T {
 func TestGet(t *testing.T) {
 	funcName := pkgName + ".TestGet"
 
-	st := Get()
+	st := callstack.Get()
 	check.Equal(t, slices.Collect(st.Frames())[0].Func(), funcName)
 	check.True(t, strings.Contains(st.String(), funcName))
 
 	const depth = 129
 	expectDepth := len(slices.Collect(st.Frames())) + depth
 
-	frames := slices.Collect(recurse(depth, Get).Frames())
+	frames := slices.Collect(recurse(depth, callstack.Get).Frames())
 	check.Equalf(t, len(frames), expectDepth, "%s", st)
 	check.Equal(t, frames[depth].Func(), funcName)
 }
 
 func TestStackIters(t *testing.T) {
 	recurse(10, func() any {
-		for range Get().Frames() {
+		for range callstack.Get().Frames() {
 			break
 		}
 
@@ -47,7 +48,7 @@ func TestStackIters(t *testing.T) {
 }
 
 func TestStackString(t *testing.T) {
-	var stack Stack
+	var stack callstack.Stack
 	check.Equal(t, stack.String(), "")
 }
 
@@ -56,7 +57,7 @@ func BenchmarkGet(b *testing.B) {
 
 	recurse(32, func() any {
 		for b.Loop() {
-			Get()
+			callstack.Get()
 		}
 
 		return nil
@@ -0,0 +1,113 @@
+package check
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thatguystone/cog/textwrap"
+)
+
+// recvWithin receives from ch, waiting up to timeout. Anything that's ready is
+// always received, even if timeout is 0.
+func recvWithin[T any](ch <-chan T, timeout time.Duration) (v T, ok, received bool) {
+	select {
+	case v, ok = <-ch:
+		return v, ok, true
+	default:
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case v, ok = <-ch:
+		return v, ok, true
+	case <-timer.C:
+		return v, false, false
+	}
+}
+
+func checkReceives[T any](ch <-chan T, timeout time.Duration) (v T, msg string, ok bool) {
+	v, ok, received := recvWithin(ch, timeout)
+	switch {
+	case !received:
+		msg = fmt.Sprintf("Expected to receive from %T within %s, got nothing", ch, timeout)
+	case !ok:
+		msg = fmt.Sprintf("Expected to receive from %T, but it was closed", ch)
+	}
+
+	return
+}
+
+func checkReceivesEqual[T any](ch <-chan T, e T, timeout time.Duration) (string, bool) {
+	v, msg, ok := checkReceives(ch, timeout)
+	if !ok {
+		return msg, false
+	}
+
+	msg, ok = checkEqual(v, e)
+	if ok {
+		return "", true
+	}
+
+	return fmt.Sprintf("Received unexpected value from %T:\n", ch) +
+		textwrap.Indent(msg, dumpIndent), false
+}
+
+func checkNeverReceives[T any](ch <-chan T, d time.Duration) (string, bool) {
+	v, ok, received := recvWithin(ch, d)
+	switch {
+	case !received:
+		return "", true
+	case !ok:
+		return fmt.Sprintf("Expected not to receive from %T, but it was closed", ch), false
+	}
+
+	msg := fmt.Sprintf("Expected not to receive from %T within %s, got:\n", ch, d)
+	return msg + dump(v, 1), false
+}
+
+func checkClosed[T any](ch <-chan T) (string, bool) {
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return "", true
+		}
+
+		msg := fmt.Sprintf("Expected %T to be closed, but received:\n", ch)
+		return msg + dump(v, 1), false
+
+	default:
+		return fmt.Sprintf("Expected %T to be closed, but it's open and empty", ch), false
+	}
+}
+
+func checkSendsWithin[T any](ch chan<- T, v T, timeout time.Duration) (msg string, ok bool) {
+	defer func() {
+		// Sending on a closed channel panics
+		if r := recover(); r != nil {
+			msg = fmt.Sprintf("Expected to send to %T, but it was closed:\n", ch) +
+				dump(v, 1)
+			ok = false
+		}
+	}()
+
+	// Like recvWithin, always send if it wouldn't block
+	select {
+	case ch <- v:
+		return "", true
+	default:
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case ch <- v:
+		return "", true
+
+	case <-timer.C:
+		msg = fmt.Sprintf("Expected to send to %T within %s, but it blocked:\n", ch, timeout)
+		return msg + dump(v, 1), false
+	}
+}
@@ -0,0 +1,118 @@
+package check
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testChanTimeout = 10 * time.Millisecond
+
+func TestReceives(t *testing.T) {
+	tb := new(testTB)
+
+	ch := make(chan int, 1)
+	ch <- 1
+
+	v, ok := Receives(tb, ch, testChanTimeout)
+	True(t, ok)
+	Equal(t, v, 1)
+	Equal(t, len(tb.errors), 0)
+
+	_, ok = Receives(tb, ch, testChanTimeout)
+	False(t, ok)
+	Equal(t, len(tb.errors), 1)
+	Contains(t, tb.errors[0], "Expected to receive from <-chan int within 10ms, got nothing")
+
+	close(ch)
+	_, ok = Receives(tb, ch, testChanTimeout)
+	False(t, ok)
+	Contains(t, tb.errors[1], "but it was closed")
+
+	MustReceive(tb, ch, testChanTimeout)
+	Equal(t, len(tb.fatals), 1)
+}
+
+func TestCheckZeroTimeout(t *testing.T) {
+	ch := make(chan int, 1)
+
+	// With a 0 timeout, a ready channel and the timer race unless the channel
+	// is checked first
+	for range 100 {
+		testCheck(checkSendsWithin(ch, 1, 0))(t, true)
+		testCheck(checkNeverReceives(ch, 0))(t, false)
+
+		ch <- 1
+		_, _, ok := checkReceives(ch, 0)
+		True(t, ok)
+	}
+}
+
+func TestCheckReceivesEqual(t *testing.T) {
+	ch := make(chan string, 2)
+	ch <- "a"
+	ch <- "b"
+
+	testCheck(checkReceivesEqual(ch, "a", testChanTimeout))(t, true)
+	testCheck(checkReceivesEqual(ch, "a", testChanTimeout))(t, false)
+	testCheck(checkReceivesEqual(ch, "a", testChanTimeout))(t, false)
+
+	ch <- "b"
+	msg, _ := checkReceivesEqual(ch, "a", testChanTimeout)
+	True(t, strings.HasPrefix(msg, "Received unexpected value from <-chan string:\n"))
+	Contains(t, msg, `"b"`)
+}
+
+func TestCheckNeverReceives(t *testing.T) {
+	ch := make(chan int, 1)
+	testCheck(checkNeverReceives(ch, testChanTimeout))(t, true)
+
+	ch <- 1
+	testCheck(checkNeverReceives(ch, testChanTimeout))(t, false)
+
+	ch <- 2
+	msg, _ := checkNeverReceives(ch, testChanTimeout)
+	Equal(
+		t,
+		msg,
+		"Expected not to receive from <-chan int within 10ms, got:\n"+
+			dumpIndent+"int(2)",
+	)
+
+	close(ch)
+	testCheck(checkNeverReceives(ch, testChanTimeout))(t, false)
+}
+
+func TestCheckClosed(t *testing.T) {
+	ch := make(chan int, 1)
+	testCheck(checkClosed(ch))(t, false)
+
+	ch <- 1
+	msg, _ := checkClosed(ch)
+	Equal(
+		t,
+		msg,
+		"Expected <-chan int to be closed, but received:\n"+
+			dumpIndent+"int(1)",
+	)
+
+	close(ch)
+	testCheck(checkClosed(ch))(t, true)
+}
+
+func TestCheckSendsWithin(t *testing.T) {
+	ch := make(chan int, 1)
+	testCheck(checkSendsWithin(ch, 1, testChanTimeout))(t, true)
+	testCheck(checkSendsWithin(ch, 2, testChanTimeout))(t, false)
+
+	<-ch
+	close(ch)
+	msg, ok := checkSendsWithin(ch, 3, testChanTimeout)
+	False(t, ok)
+	Equal(
+		t,
+		msg,
+		"Expected to send to chan<- int, but it was closed:\n"+
+			dumpIndent+"int(3)",
+	)
+}
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"strings"
 	"text/template"
 
 	"github.com/thatguystone/cog/assert"
@@ -19,8 +20,8 @@ func main() {
 	tmpls := []*template.Template{
 		newTemplate(`
 			// {{ .Doc }}
-			func {{ .Name }}(t Error, {{ .Args }}) bool {
-				if msg, ok := {{ .Check }}; !ok {
+			func {{ .Name }}{{ .TypeParams }}(t {{ .ErrorType }}, {{ .Args }}{{ with .Variadic }}, {{ . }}{{ end }}) bool {
+				if msg, ok := {{ .CheckFor "t" }}; !ok {
 					t.Helper()
 					t.Error("\n" + msg)
 					return false
@@ -29,10 +30,10 @@ func main() {
 				return true
 			}
 		`),
-		newTemplate(`
+		newTemplate(`{{ if not .NoFormat }}
 			// {{ .Doc }}
-			func {{ .Name }}f(t Error, {{ .Args }}, format string, args ...any) bool {
-				if msg, ok := {{ .Check }}; !ok {
+			func {{ .Name }}f{{ .TypeParams }}(t {{ .ErrorType }}, {{ .Args }}{{ with .VariadicSlice }}, {{ . }}{{ end }}, format string, args ...any) bool {
+				if msg, ok := {{ .CheckFor "t" }}; !ok {
 					t.Helper()
 					t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 					return false
@@ -40,25 +41,175 @@ func main() {
 
 				return true
 			}
-		`),
+		{{ end }}`),
 		newTemplate(`
 			// {{ .Doc }}
-			func Must{{ or .Must .Name  }}(t Fatal, {{ .Args }}) {
-				if msg, ok := {{ .Check }}; !ok {
+			func Must{{ or .Must .Name }}{{ .TypeParams }}(t {{ .FatalType }}, {{ .Args }}{{ with .Variadic }}, {{ . }}{{ end }}) {
+				if msg, ok := {{ .CheckFor "t" }}; !ok {
 					t.Helper()
 					t.Fatal("\n" + msg)
 				}
 			}
 		`),
+		newTemplate(`{{ if not .NoFormat }}
+			// {{ .Doc }}
+			func Must{{ or .Must .Name }}f{{ .TypeParams }}(t {{ .FatalType }}, {{ .Args }}{{ with .VariadicSlice }}, {{ . }}{{ end }}, format string, args ...any) {
+				if msg, ok := {{ .CheckFor "t" }}; !ok {
+					t.Helper()
+					t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+				}
+			}
+		{{ end }}`),
+	}
+
+	// Methods can't have type parameters, so generic funcs are skipped
+	methodTmpls := []*template.Template{
+		newTemplate(`
+			// {{ .Doc }}
+			func (c Checker) {{ .Name }}({{ .Args }}{{ with .Variadic }}, {{ . }}{{ end }}) bool {
+				if msg, ok := {{ .CheckFor "c.t" }}; !ok {
+					c.t.Helper()
+					c.t.Error("\n" + msg)
+					return false
+				}
+
+				return true
+			}
+		`),
+		newTemplate(`{{ if not .NoFormat }}
+			// {{ .Doc }}
+			func (c Checker) {{ .Name }}f({{ .Args }}{{ with .VariadicSlice }}, {{ . }}{{ end }}, format string, args ...any) bool {
+				if msg, ok := {{ .CheckFor "c.t" }}; !ok {
+					c.t.Helper()
+					c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+					return false
+				}
+
+				return true
+			}
+		{{ end }}`),
+		newTemplate(`
+			// {{ .Doc }}
+			func (c MustChecker) {{ .Name }}({{ .Args }}{{ with .Variadic }}, {{ . }}{{ end }}) {
+				if msg, ok := {{ .CheckFor "c.t" }}; !ok {
+					c.t.Helper()
+					c.t.Fatal("\n" + msg)
+				}
+			}
+		`),
+		newTemplate(`{{ if not .NoFormat }}
+			// {{ .Doc }}
+			func (c MustChecker) {{ .Name }}f({{ .Args }}{{ with .VariadicSlice }}, {{ . }}{{ end }}, format string, args ...any) {
+				if msg, ok := {{ .CheckFor "c.t" }}; !ok {
+					c.t.Helper()
+					c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+				}
+			}
+		{{ end }}`),
+	}
+
+	// Funcs that return values also return whether the check passed, unless
+	// they're Must funcs or NoOK is set
+	valueTmpls := []*template.Template{
 		newTemplate(`
 			// {{ .Doc }}
-			func Must{{ or .Must .Name }}f(t Fatal, {{ .Args }}, format string, args ...any) {
-				if msg, ok := {{ .Check }}; !ok {
+			func {{ .Name }}{{ .TypeParams }}(t {{ .ErrorType }}, {{ .Args }}{{ with .Variadic }}, {{ . }}{{ end }}) ({{ .Returns }}{{ if not .NoOK }}, ok bool{{ end }}) {
+				{{ .ReturnNames }}, msg, ok := {{ .CheckFor "t" }}
+				if !ok {
+					t.Helper()
+					t.Error("\n" + msg)
+				}
+
+				return
+			}
+		`),
+		newTemplate(`{{ if not .NoFormat }}
+			// {{ .Doc }}
+			func {{ .Name }}f{{ .TypeParams }}(t {{ .ErrorType }}, {{ .Args }}{{ with .VariadicSlice }}, {{ . }}{{ end }}, format string, args ...any) ({{ .Returns }}{{ if not .NoOK }}, ok bool{{ end }}) {
+				{{ .ReturnNames }}, msg, ok := {{ .CheckFor "t" }}
+				if !ok {
+					t.Helper()
+					t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+				}
+
+				return
+			}
+		{{ end }}`),
+		newTemplate(`
+			// {{ .Doc }}
+			func Must{{ or .Must .Name }}{{ .TypeParams }}(t {{ .FatalType }}, {{ .Args }}{{ with .Variadic }}, {{ . }}{{ end }}) ({{ .Returns }}) {
+				{{ .ReturnNames }}, msg, ok := {{ .CheckFor "t" }}
+				if !ok {
+					t.Helper()
+					t.Fatal("\n" + msg)
+				}
+
+				return
+			}
+		`),
+		newTemplate(`{{ if not .NoFormat }}
+			// {{ .Doc }}
+			func Must{{ or .Must .Name }}f{{ .TypeParams }}(t {{ .FatalType }}, {{ .Args }}{{ with .VariadicSlice }}, {{ . }}{{ end }}, format string, args ...any) ({{ .Returns }}) {
+				{{ .ReturnNames }}, msg, ok := {{ .CheckFor "t" }}
+				if !ok {
 					t.Helper()
 					t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 				}
+
+				return
+			}
+		{{ end }}`),
+	}
+
+	valueMethodTmpls := []*template.Template{
+		newTemplate(`
+			// {{ .Doc }}
+			func (c Checker) {{ .Name }}({{ .Args }}{{ with .Variadic }}, {{ . }}{{ end }}) ({{ .Returns }}{{ if not .NoOK }}, ok bool{{ end }}) {
+				{{ .ReturnNames }}, msg, ok := {{ .CheckFor "c.t" }}
+				if !ok {
+					c.t.Helper()
+					c.t.Error("\n" + msg)
+				}
+
+				return
 			}
 		`),
+		newTemplate(`{{ if not .NoFormat }}
+			// {{ .Doc }}
+			func (c Checker) {{ .Name }}f({{ .Args }}{{ with .VariadicSlice }}, {{ . }}{{ end }}, format string, args ...any) ({{ .Returns }}{{ if not .NoOK }}, ok bool{{ end }}) {
+				{{ .ReturnNames }}, msg, ok := {{ .CheckFor "c.t" }}
+				if !ok {
+					c.t.Helper()
+					c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+				}
+
+				return
+			}
+		{{ end }}`),
+		newTemplate(`
+			// {{ .Doc }}
+			func (c MustChecker) {{ .Name }}({{ .Args }}{{ with .Variadic }}, {{ . }}{{ end }}) ({{ .Returns }}) {
+				{{ .ReturnNames }}, msg, ok := {{ .CheckFor "c.t" }}
+				if !ok {
+					c.t.Helper()
+					c.t.Fatal("\n" + msg)
+				}
+
+				return
+			}
+		`),
+		newTemplate(`{{ if not .NoFormat }}
+			// {{ .Doc }}
+			func (c MustChecker) {{ .Name }}f({{ .Args }}{{ with .VariadicSlice }}, {{ . }}{{ end }}, format string, args ...any) ({{ .Returns }}) {
+				{{ .ReturnNames }}, msg, ok := {{ .CheckFor "c.t" }}
+				if !ok {
+					c.t.Helper()
+					c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+				}
+
+				return
+			}
+		{{ end }}`),
 	}
 
 	b := generate.New()
@@ -66,21 +217,81 @@ func main() {
 	b.WriteString("\nimport()\n")
 
 	for _, fn := range funcs {
-		for _, tmpl := range tmpls {
+		fnTmpls, fnMethodTmpls := tmpls, methodTmpls
+		if fn.Returns != "" {
+			fnTmpls, fnMethodTmpls = valueTmpls, valueMethodTmpls
+		}
+
+		for _, tmpl := range fnTmpls {
 			err := tmpl.Execute(b, fn)
 			assert.Nil(err)
 		}
+
+		if fn.TypeParams == "" {
+			for _, tmpl := range fnMethodTmpls {
+				err := tmpl.Execute(b, fn)
+				assert.Nil(err)
+			}
+		}
 	}
 
 	b.WriteFile()
 }
 
 type Func struct {
-	Name  string
-	Must  string
-	Args  string
-	Check string
-	Doc   string
+	Name       string
+	Must       string
+	TypeParams string
+	Args       string
+	Variadic   string // Trailing variadic arg; becomes a slice in f funcs
+	Returns    string // Named values that Check returns before its msg and ok
+	Check      string // Refers to the test as {{ .T }}, if it needs it
+	Doc        string
+	Named      bool // Takes a NamedError/NamedFatal instead of Error/Fatal
+	NoFormat   bool // Don't generate f funcs
+	NoOK       bool // Don't return ok along with Returns
+}
+
+// VariadicSlice converts Variadic into a slice arg, so that it can be followed
+// by a format string and args.
+func (fn Func) VariadicSlice() string {
+	return strings.Replace(fn.Variadic, "...", "[]", 1)
+}
+
+// ReturnNames gets the names of the values in Returns.
+func (fn Func) ReturnNames() string {
+	var names []string
+	for ret := range strings.SplitSeq(fn.Returns, ",") {
+		name, _, _ := strings.Cut(strings.TrimSpace(ret), " ")
+		names = append(names, name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// CheckFor renders Check for the test t.
+func (fn Func) CheckFor(t string) string {
+	b := new(strings.Builder)
+	err := newTemplate(fn.Check).Execute(b, struct{ T string }{t})
+	assert.Nil(err)
+
+	return b.String()
+}
+
+func (fn Func) ErrorType() string {
+	if fn.Named {
+		return "NamedError"
+	}
+
+	return "Error"
+}
+
+func (fn Func) FatalType() string {
+	if fn.Named {
+		return "NamedFatal"
+	}
+
+	return "Fatal"
 }
 
 var funcs = []Func{
@@ -100,7 +311,40 @@ var funcs = []Func{
 		Name:  "Equal",
 		Args:  "g, e any",
 		Check: "checkEqual(g, e)",
-		Doc:   "Check that two things are equal; e is the expected value, g is what was got.",
+		Doc:   "Check that two things are equal; e is the expected value, g is what was got. An iter.Seq is compared as a slice of the values it yields, and an iter.Seq2 as a map when compared to one.",
+	},
+	{
+		Name:       "EqualT",
+		TypeParams: "[T any]",
+		Args:       "g, e T",
+		Check:      "checkEqual(g, e)",
+		Doc:        "Check that two things of the same type are equal; e is the expected value, g is what was got. This is a type-safe version of [Equal].",
+	},
+	{
+		Name:     "EqualWith",
+		Args:     "g, e any",
+		Variadic: "opts ...EqualOption",
+		Check:    "checkEqualWith(g, e, opts...)",
+		Doc:      "Check that two things are equal, as customized by opts; e is the expected value, g is what was got.",
+	},
+	{
+		Name:     "Match",
+		Args:     "g, pattern any",
+		Variadic: "opts ...EqualOption",
+		Check:    "checkMatch(g, pattern, opts...)",
+		Doc:      "Check that g matches pattern, which is usually a partially filled-in value of the same type. Zero values in pattern match anything, maps in pattern only need to be a subset of those in g, and fields can be explicitly skipped with [IgnoreFields]. Other opts customize the comparison as in [EqualWith].",
+	},
+	{
+		Name:  "That",
+		Args:  "v any, m Matcher",
+		Check: "checkThat(v, m)",
+		Doc:   "Check that v is matched by m.",
+	},
+	{
+		Name:  "JSONEq",
+		Args:  "g, e any",
+		Check: "checkJSONEq(g, e)",
+		Doc:   "Check that g and e are semantically equal JSON, ignoring object key order, whitespace, and number formatting. Strings, []byte, and [json.RawMessage] are parsed as JSON; anything else is marshaled first.",
 	},
 	{
 		Name:  "NotEqual",
@@ -108,6 +352,74 @@ var funcs = []Func{
 		Check: "checkNotEqual(g, e)",
 		Doc:   "Check that two things are not equal; e is the expected value, g is what was got.",
 	},
+	{
+		Name:       "NotEqualT",
+		TypeParams: "[T any]",
+		Args:       "g, e T",
+		Check:      "checkNotEqual(g, e)",
+		Doc:        "Check that two things of the same type are not equal; e is the expected value, g is what was got. This is a type-safe version of [NotEqual].",
+	},
+	{
+		Name:       "Less",
+		TypeParams: "[T cmp.Ordered]",
+		Args:       "a, b T",
+		Check:      "checkLess(a, b)",
+		Doc:        "Check that a < b.",
+	},
+	{
+		Name:       "LessOrEqual",
+		TypeParams: "[T cmp.Ordered]",
+		Args:       "a, b T",
+		Check:      "checkLessOrEqual(a, b)",
+		Doc:        "Check that a <= b.",
+	},
+	{
+		Name:       "Greater",
+		TypeParams: "[T cmp.Ordered]",
+		Args:       "a, b T",
+		Check:      "checkGreater(a, b)",
+		Doc:        "Check that a > b.",
+	},
+	{
+		Name:       "GreaterOrEqual",
+		TypeParams: "[T cmp.Ordered]",
+		Args:       "a, b T",
+		Check:      "checkGreaterOrEqual(a, b)",
+		Doc:        "Check that a >= b.",
+	},
+	{
+		Name:       "Positive",
+		TypeParams: "[T cmp.Ordered]",
+		Args:       "v T",
+		Check:      "checkPositive(v)",
+		Doc:        "Check that v is greater than the zero value of T.",
+	},
+	{
+		Name:       "Negative",
+		TypeParams: "[T cmp.Ordered]",
+		Args:       "v T",
+		Check:      "checkNegative(v)",
+		Doc:        "Check that v is less than the zero value of T.",
+	},
+	{
+		Name:       "Between",
+		TypeParams: "[T cmp.Ordered]",
+		Args:       "v, lo, hi T",
+		Check:      "checkBetween(v, lo, hi)",
+		Doc:        "Check that lo <= v <= hi.",
+	},
+	{
+		Name:  "InDelta",
+		Args:  "g, e, delta float64",
+		Check: "checkInDelta(g, e, delta)",
+		Doc:   "Check that g and e differ by no more than delta; e is the expected value, g is what was got.",
+	},
+	{
+		Name:  "InEpsilon",
+		Args:  "g, e, epsilon float64",
+		Check: "checkInEpsilon(g, e, epsilon)",
+		Doc:   "Check that the relative error between g and e, |g-e| / |e|, is no more than epsilon; e is the expected value, g is what was got.",
+	},
 	{
 		Name:  "Nil",
 		Args:  "v any",
@@ -144,6 +456,33 @@ var funcs = []Func{
 		Check: "checkErrAs(err, target)",
 		Doc:   "Check that [errors.As] returns true.",
 	},
+	{
+		Name:       "ErrorAs",
+		TypeParams: "[E error]",
+		Args:       "err error",
+		Returns:    "target E",
+		Check:      "checkErrorAs[E](err)",
+		Doc:        "Check that err has an error of type E in its tree, returning the first one found, as in [errors.As].",
+	},
+	{
+		Name:  "ErrorContains",
+		Args:  "err error, substr string",
+		Check: "checkErrorContains(err, substr)",
+		Doc:   "Check that err is not nil and that its message contains substr.",
+	},
+	{
+		Name:  "ErrorMatches",
+		Args:  "err error, re any",
+		Check: "checkErrorMatches(err, re)",
+		Doc:   "Check that err is not nil and that its message matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.",
+	},
+	{
+		Name:     "ErrorIsAny",
+		Args:     "err error",
+		Variadic: "targets ...error",
+		Check:    "checkErrorIsAny(err, targets...)",
+		Doc:      "Check that [errors.Is] returns true for at least one of targets.",
+	},
 	{
 		Name:  "HasKey",
 		Must:  "HaveKey",
@@ -151,6 +490,14 @@ var funcs = []Func{
 		Check: "checkHasKey(m, k)",
 		Doc:   "Check that map m contains key k.",
 	},
+	{
+		Name:       "HasKeyT",
+		Must:       "HaveKeyT",
+		TypeParams: "[M ~map[K]V, K comparable, V any]",
+		Args:       "m M, k K",
+		Check:      "checkHasKey(m, k)",
+		Doc:        "Check that map m contains key k. This is a type-safe version of [HasKey].",
+	},
 	{
 		Name:  "NotHasKey",
 		Must:  "NotHaveKey",
@@ -158,19 +505,124 @@ var funcs = []Func{
 		Check: "checkNotHasKey(m, k)",
 		Doc:   "Check that map m does not contain key k.",
 	},
+	{
+		Name:       "NotHasKeyT",
+		Must:       "NotHaveKeyT",
+		TypeParams: "[M ~map[K]V, K comparable, V any]",
+		Args:       "m M, k K",
+		Check:      "checkNotHasKey(m, k)",
+		Doc:        "Check that map m does not contain key k. This is a type-safe version of [NotHasKey].",
+	},
 	{
 		Name:  "Contains",
 		Must:  "Contain",
 		Args:  "iter, v any",
 		Check: "checkContains(iter, v)",
-		Doc:   "Check that iter contains value v. Iter must be one of: map, slice, array, or string.",
+		Doc:   "Check that iter contains value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).",
+	},
+	{
+		Name:       "ContainsT",
+		Must:       "ContainT",
+		TypeParams: "[S ~[]E, E any]",
+		Args:       "s S, v E",
+		Check:      "checkContains(s, v)",
+		Doc:        "Check that slice s contains value v. This is a type-safe version of [Contains].",
 	},
 	{
 		Name:  "NotContains",
 		Must:  "NotContain",
 		Args:  "iter, v any",
 		Check: "checkNotContains(iter, v)",
-		Doc:   "Check that iter does not contain value v. Iter must be one of: map, slice, array, or string",
+		Doc:   "Check that iter does not contain value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).",
+	},
+	{
+		Name:       "NotContainsT",
+		Must:       "NotContainT",
+		TypeParams: "[S ~[]E, E any]",
+		Args:       "s S, v E",
+		Check:      "checkNotContains(s, v)",
+		Doc:        "Check that slice s does not contain value v. This is a type-safe version of [NotContains].",
+	},
+	{
+		Name:  "Matches",
+		Args:  "s string, re any",
+		Check: "checkMatches(s, re)",
+		Doc:   "Check that s matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.",
+	},
+	{
+		Name:  "NotMatches",
+		Args:  "s string, re any",
+		Check: "checkNotMatches(s, re)",
+		Doc:   "Check that s does not match the regexp re, which is either a [*regexp.Regexp] or a string to compile.",
+	},
+	{
+		Name:  "HasPrefix",
+		Must:  "HavePrefix",
+		Args:  "s, prefix string",
+		Check: "checkHasPrefix(s, prefix)",
+		Doc:   "Check that s starts with prefix.",
+	},
+	{
+		Name:  "HasSuffix",
+		Must:  "HaveSuffix",
+		Args:  "s, suffix string",
+		Check: "checkHasSuffix(s, suffix)",
+		Doc:   "Check that s ends with suffix.",
+	},
+	{
+		Name:  "EqualFold",
+		Args:  "g, e string",
+		Check: "checkEqualFold(g, e)",
+		Doc:   "Check that two strings are equal under simple Unicode case-folding; e is the expected value, g is what was got.",
+	},
+	{
+		Name:     "ContainsInOrder",
+		Must:     "ContainInOrder",
+		Args:     "s string",
+		Variadic: "substrs ...string",
+		Check:    "checkContainsInOrder(s, substrs...)",
+		Doc:      "Check that s contains every one of substrs, in order, without overlapping.",
+	},
+	{
+		Name:  "Len",
+		Must:  "HaveLen",
+		Args:  "v any, n int",
+		Check: "checkLen(v, n)",
+		Doc:   "Check that collection v has n elements. Collections are slices, arrays, maps, strings (by bytes), channels (by buffered values), and iter.Seq and iter.Seq2.",
+	},
+	{
+		Name:  "Empty",
+		Must:  "BeEmpty",
+		Args:  "v any",
+		Check: "checkEmpty(v)",
+		Doc:   "Check that collection v has no elements. See [Len] for what counts as a collection.",
+	},
+	{
+		Name:  "NotEmpty",
+		Must:  "NotBeEmpty",
+		Args:  "v any",
+		Check: "checkNotEmpty(v)",
+		Doc:   "Check that collection v has elements. See [Len] for what counts as a collection.",
+	},
+	{
+		Name:  "ElementsMatch",
+		Args:  "g, e any",
+		Check: "checkElementsMatch(g, e)",
+		Doc:   "Check that collections g and e have the same elements, in any order, including duplicates. Maps are compared by their values, strings by their runes, and channels are drained of buffered values. See [Len] for what counts as a collection.",
+	},
+	{
+		Name:  "SubsetOf",
+		Must:  "BeSubsetOf",
+		Args:  "sub, super any",
+		Check: "checkSubsetOf(sub, super)",
+		Doc:   "Check that every element of collection sub is in collection super. Elements are gathered as in [ElementsMatch].",
+	},
+	{
+		Name:  "NoDuplicates",
+		Must:  "HaveNoDuplicates",
+		Args:  "v any",
+		Check: "checkNoDuplicates(v)",
+		Doc:   "Check that no two elements of collection v are equal. Elements are gathered as in [ElementsMatch].",
 	},
 	{
 		Name:  "Panics",
@@ -193,6 +645,77 @@ var funcs = []Func{
 		Check: "checkPanicsWith(recovers, fn)",
 		Doc:   "Check that the given function panics with the given value.",
 	},
+	{
+		Name:       "PanicsWithT",
+		Must:       "PanicWithT",
+		TypeParams: "[T any]",
+		Args:       "recovers T, fn func()",
+		Check:      "checkPanicsWith(recovers, fn)",
+		Doc:        "Check that the given function panics with the given value. This is a type-safe version of [PanicsWith].",
+	},
+	{
+		Name:  "PanicsWithError",
+		Must:  "PanicWithError",
+		Args:  "target error, fn func()",
+		Check: "checkPanicsWithError(target, fn)",
+		Doc:   "Check that the given function panics with an error for which [errors.Is] returns true for target.",
+	},
+	{
+		Name:  "PanicsMatching",
+		Must:  "PanicMatching",
+		Args:  "re any, fn func()",
+		Check: "checkPanicsMatching(re, fn)",
+		Doc:   "Check that the given function panics with a value whose [fmt.Sprint] matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.",
+	},
+	{
+		Name:    "Recover",
+		Args:    "fn func()",
+		Returns: "value any, stack callstack.Stack",
+		Check:   "checkRecover(fn)",
+		NoOK:    true,
+		Doc:     "Call fn and check that it panics, returning the recovered value and the stack where the panic happened.",
+	},
+	{
+		Name:       "Receives",
+		Must:       "Receive",
+		TypeParams: "[T any]",
+		Args:       "ch <-chan T, timeout time.Duration",
+		Returns:    "v T",
+		Check:      "checkReceives(ch, timeout)",
+		Doc:        "Check that a value is received from ch within timeout, returning it.",
+	},
+	{
+		Name:       "ReceivesEqual",
+		Must:       "ReceiveEqual",
+		TypeParams: "[T any]",
+		Args:       "ch <-chan T, e T, timeout time.Duration",
+		Check:      "checkReceivesEqual(ch, e, timeout)",
+		Doc:        "Check that a value is received from ch within timeout, and that it's equal to e.",
+	},
+	{
+		Name:       "NeverReceives",
+		Must:       "NeverReceive",
+		TypeParams: "[T any]",
+		Args:       "ch <-chan T, d time.Duration",
+		Check:      "checkNeverReceives(ch, d)",
+		Doc:        "Check that nothing is received from ch, and that it isn't closed, for d.",
+	},
+	{
+		Name:       "Closed",
+		Must:       "BeClosed",
+		TypeParams: "[T any]",
+		Args:       "ch <-chan T",
+		Check:      "checkClosed(ch)",
+		Doc:        "Check that ch is closed, without waiting. If ch has a value buffered, it is received.",
+	},
+	{
+		Name:       "SendsWithin",
+		Must:       "SendWithin",
+		TypeParams: "[T any]",
+		Args:       "ch chan<- T, v T, timeout time.Duration",
+		Check:      "checkSendsWithin(ch, v, timeout)",
+		Doc:        "Check that v can be sent on ch within timeout.",
+	},
 	{
 		Name:  "EventuallyTrue",
 		Args:  "numTries int, fn func(i int) bool",
@@ -205,4 +728,32 @@ var funcs = []Func{
 		Check: "checkEventuallyNil(numTries, fn)",
 		Doc:   "Poll the given function, a max of numTries times, until it doesn't return an error. This is mainly a helper used to exhaust error pathways.",
 	},
+	{
+		Name:  "EventuallyTrueWithin",
+		Args:  "ctx context.Context, p Poll, fn func(i int) bool",
+		Check: "checkEventuallyTrueWithin(ctx, p, fn)",
+		Doc:   "Poll the given function, as configured by p, until it returns true or ctx is done.",
+	},
+	{
+		Name:  "EventuallyNilWithin",
+		Args:  "ctx context.Context, p Poll, fn func(i int) error",
+		Check: "checkEventuallyNilWithin(ctx, p, fn)",
+		Doc:   "Poll the given function, as configured by p, until it doesn't return an error or ctx is done.",
+	},
+	{
+		Name:  "Golden",
+		Args:  "name string, got any",
+		Check: "checkGolden(goldenPath({{ .T }}.Name(), name), got, Updating())",
+		Doc:   "Check that got matches the contents of the golden file at testdata/<TestName>/<name>.golden. Strings and []byte are compared as-is; everything else is compared by its [Dump]. When [Updating], the golden file is written instead.",
+		Named: true,
+	},
+	{
+		Name:  "Snapshot",
+		Args:  "got any, expect string",
+		Check: "checkSnapshot(callstack.Caller(1), got, expect)",
+		Doc:   "Check that got matches the inline snapshot expect. Strings are compared as-is; everything else is compared by its [Dump]. When [Updating], the expect literal in the calling source file is rewritten to match got.",
+
+		// expect has to be the last arg to be found when rewriting
+		NoFormat: true,
+	},
 }
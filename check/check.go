@@ -4,12 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
-	"runtime/debug"
+	"slices"
 	"strings"
 	"unicode"
 
 	"github.com/peter-evans/patience"
-	"github.com/thatguystone/cog/textwrap"
 )
 
 type Error interface {
@@ -38,40 +37,162 @@ func checkFalse(cond bool) (string, bool) {
 	return "Expected false", false
 }
 
-func equalMsg(g, e any) string {
+func equalMsg(g, e any, opts ...EqualOption) string {
 	var (
 		gs = dump(g, 0)
-		gl = strings.Split(gs, "\n")
 		es = dump(e, 0)
+	)
+
+	// Long lists are elided, so make sure the difference is shown
+	if i, ok := listDiffIndex(g, e); ok {
+		gs = dumpListAround(reflect.ValueOf(g), i)
+		es = dumpListAround(reflect.ValueOf(e), i)
+	}
+
+	var (
+		gl = strings.Split(gs, "\n")
 		el = strings.Split(es, "\n")
 	)
 
+	if msg, ok := multilineStringMsg(g, e); ok {
+		return msg
+	}
+
 	if len(gl) == 1 && len(el) == 1 {
-		return fmt.Sprintf(""+
-			"Expected: %s\n"+
-			"       == %s",
-			gs,
-			es,
-		)
+		// Long strings are elided, so make sure the difference is shown
+		if off, ok := stringDiffOffset(g, e); ok {
+			gs = dumpStringAround(reflect.ValueOf(g), off)
+			es = dumpStringAround(reflect.ValueOf(e), off)
+		}
+
+		return singleLineEqualMsg(g, e, gs, es)
 	}
 
 	var (
 		b     = new(strings.Builder)
-		diffs = patience.Diff(gl, el)
+		paths = pathDiffs(g, e, opts)
 	)
 
+	b.WriteString("Expected values to be equal:\n")
+	writePaths(b, paths)
+
+	writeLineDiff(b, gl, el)
+	return b.String()
+}
+
+// singleLineEqualMsg shows two single-line dumps, one above the other, with a
+// caret under the first difference between them.
+func singleLineEqualMsg(g, e any, gs, es string) string {
 	const (
-		prefixLen = 2
-		prelude   = "Expected values to be equal:\n"
+		gotPrefix    = "Expected: "
+		expectPrefix = "       == "
 	)
 
-	n := len(prelude) + (len(dumpIndent)+prefixLen+1)*len(diffs)
+	off, isStr := stringDiffOffset(g, e)
+
+	prefix, _ := commonAffixes(gs, es)
+	caret := ""
+	if gs != es || (isStr && g != e) {
+		caret = "\n" + strings.Repeat(" ", len(expectPrefix)) +
+			caretLine(es[:prefix])
+
+		if isStr {
+			caret += fmt.Sprintf(" first difference at byte %d", off)
+		}
+	}
+
+	if colorOutput() {
+		gs, es = ansiRed+highlightChange(gs, es)+ansiReset,
+			ansiGreen+highlightChange(es, gs)+ansiReset
+	}
+
+	return gotPrefix + gs + "\n" + expectPrefix + es + caret
+}
+
+// caretLine creates a line with a caret that lines up with the end of prefix
+func caretLine(prefix string) string {
+	return strings.Map(
+		func(r rune) rune {
+			if r == '\t' {
+				return r
+			}
+
+			return ' '
+		},
+		prefix,
+	) + "^"
+}
+
+// stringDiffOffset finds the byte offset of the first difference between g and
+// e, if they're both strings of the same type
+func stringDiffOffset(g, e any) (int, bool) {
+	gv := reflect.ValueOf(g)
+	ev := reflect.ValueOf(e)
+	if gv.Kind() != reflect.String || ev.Kind() != reflect.String || gv.Type() != ev.Type() {
+		return 0, false
+	}
+
+	prefix, _ := commonAffixes(gv.String(), ev.String())
+	return prefix, true
+}
+
+// listDiffIndex finds the index of the first difference between g and e, if
+// they're both slices or arrays of the same type
+func listDiffIndex(g, e any) (int, bool) {
+	gv := reflect.ValueOf(g)
+	ev := reflect.ValueOf(e)
+	if !gv.IsValid() || !ev.IsValid() || gv.Type() != ev.Type() {
+		return 0, false
+	}
+
+	if k := gv.Kind(); k != reflect.Slice && k != reflect.Array {
+		return 0, false
+	}
+
+	n := min(gv.Len(), ev.Len())
+	for i := range n {
+		if !reflect.DeepEqual(gv.Index(i).Interface(), ev.Index(i).Interface()) {
+			return i, true
+		}
+	}
+
+	return n, true
+}
+
+// writeLineDiff writes an indented diff between the lines of g and e. Long runs
+// of unchanged lines are collapsed to a few lines of context.
+func writeLineDiff(b *strings.Builder, gl, el []string) {
+	writeLineDiffColor(b, gl, el, colorOutput())
+}
+
+// writeLineDiffColor is like writeLineDiff, except it colorizes changes if
+// color is set: deleted lines are red and inserted lines green, with the
+// changed parts of paired lines highlighted. A line that replaces a single,
+// similar line gets a caret under its first change.
+func writeLineDiffColor(b *strings.Builder, gl, el []string, color bool) {
+	writeDiff(b, patience.Diff(gl, el), 0, color)
+}
+
+// writeDiff writes diffs, where every line starts with a gutter of the given
+// width that isn't part of its text, eg. a line number.
+func writeDiff(b *strings.Builder, diffs []patience.DiffLine, gutter int, color bool) {
+	const prefixLen = 2
+
+	diffs = collapseDiff(diffs, diffContext)
+
+	n := (len(dumpIndent) + prefixLen + 1) * len(diffs)
 	for _, diff := range diffs {
 		n += len(diff.Text)
 	}
 
 	b.Grow(n)
-	b.WriteString(prelude)
+
+	var pairs map[int]int
+	if color {
+		pairs = pairChanges(diffs)
+	}
+
+	carets := lineCarets(diffs, gutter)
 
 	for i, diff := range diffs {
 		if i > 0 {
@@ -80,22 +201,224 @@ func equalMsg(g, e any) string {
 
 		b.WriteString(dumpIndent)
 
+		text := diff.Text
+		if j, ok := pairs[i]; ok && hasGutter(text, gutter) && hasGutter(diffs[j].Text, gutter) {
+			text = text[:gutter] + highlightChange(text[gutter:], diffs[j].Text[gutter:])
+		}
+
 		switch diff.Type {
 		case patience.Delete:
-			b.WriteString("- ")
+			writeColored(b, color, ansiRed, "- ", text)
 		case patience.Insert:
-			b.WriteString("+ ")
+			writeColored(b, color, ansiGreen, "+ ", text)
+
+			if caret, ok := carets[i]; ok {
+				b.WriteByte('\n')
+				b.WriteString(dumpIndent)
+				b.WriteString("  ")
+				b.WriteString(caret)
+			}
 		default:
 			b.WriteString("  ")
+			b.WriteString(text)
 		}
+	}
+}
+
+// lineCarets finds inserted lines that replace a single, similar deleted line,
+// and creates caret lines that point at the first change between them. Only the
+// text after each line's gutter is compared.
+func lineCarets(diffs []patience.DiffLine, gutter int) map[int]string {
+	carets := make(map[int]string)
+
+	for i := 0; i+1 < len(diffs); i++ {
+		var (
+			del = diffs[i]
+			ins = diffs[i+1]
+		)
 
-		b.WriteString(diff.Text)
+		isPair := del.Type == patience.Delete &&
+			ins.Type == patience.Insert &&
+			(i == 0 || diffs[i-1].Type == patience.Equal) &&
+			(i+2 == len(diffs) || diffs[i+2].Type == patience.Equal)
+		if !isPair || !hasGutter(del.Text, gutter) || !hasGutter(ins.Text, gutter) {
+			continue
+		}
+
+		prefix, suffix := commonAffixes(del.Text[gutter:], ins.Text[gutter:])
+		if prefix > 0 || suffix > 0 {
+			carets[i+1] = caretLine(ins.Text[:gutter+prefix])
+		}
 	}
 
-	return b.String()
+	return carets
+}
+
+func hasGutter(text string, gutter int) bool {
+	return len(text) >= gutter
+}
+
+func writeColored(b *strings.Builder, color bool, ansi, prefix, text string) {
+	if color {
+		b.WriteString(ansi)
+	}
+
+	b.WriteString(prefix)
+	b.WriteString(text)
+
+	if color {
+		b.WriteString(ansiReset)
+	}
+}
+
+// pairChanges pairs up deleted and inserted lines in each block of changes, in
+// order, so that the changes between them can be highlighted. The returned map
+// goes both ways.
+func pairChanges(diffs []patience.DiffLine) map[int]int {
+	pairs := make(map[int]int)
+
+	for i := 0; i < len(diffs); {
+		if diffs[i].Type == patience.Equal {
+			i++
+			continue
+		}
+
+		var dels, ins []int
+		for ; i < len(diffs) && diffs[i].Type != patience.Equal; i++ {
+			if diffs[i].Type == patience.Delete {
+				dels = append(dels, i)
+			} else {
+				ins = append(ins, i)
+			}
+		}
+
+		for k := range min(len(dels), len(ins)) {
+			pairs[dels[k]] = ins[k]
+			pairs[ins[k]] = dels[k]
+		}
+	}
+
+	return pairs
+}
+
+// Number of unchanged lines to show around changes in diffs
+const diffContext = 3
+
+// collapseDiff replaces runs of unchanged lines that are more than context
+// lines away from a change with a marker. If nothing changed, a long diff is
+// collapsed entirely.
+func collapseDiff(diffs []patience.DiffLine, context int) []patience.DiffLine {
+	changed := slices.ContainsFunc(diffs, func(diff patience.DiffLine) bool {
+		return diff.Type != patience.Equal
+	})
+	if !changed {
+		// Values can differ in ways their elided dumps don't show; don't
+		// bury the paths that do show it under pages of identical lines.
+		if len(diffs) <= 2*context+1 {
+			return diffs
+		}
+
+		return []patience.DiffLine{{
+			Text: fmt.Sprintf("... %d unchanged lines ...", len(diffs)),
+			Type: patience.Equal,
+		}}
+	}
+
+	ret := make([]patience.DiffLine, 0, len(diffs))
+
+	for i := 0; i < len(diffs); {
+		if diffs[i].Type != patience.Equal {
+			ret = append(ret, diffs[i])
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(diffs) && diffs[end].Type == patience.Equal {
+			end++
+		}
+
+		var (
+			run  = diffs[i:end]
+			head = context
+			tail = context
+		)
+
+		if i == 0 {
+			head = 0
+		}
+
+		if end == len(diffs) {
+			tail = 0
+		}
+
+		// Only collapse if it hides more than a single line
+		if head+tail+1 >= len(run) {
+			ret = append(ret, run...)
+		} else {
+			ret = append(ret, run[:head]...)
+			ret = append(ret, patience.DiffLine{
+				Text: fmt.Sprintf("... %d unchanged lines ...", len(run)-head-tail),
+				Type: patience.Equal,
+			})
+			ret = append(ret, run[len(run)-tail:]...)
+		}
+
+		i = end
+	}
+
+	return ret
+}
+
+// writePaths writes the given path diffs, followed by a blank line
+func writePaths(b *strings.Builder, paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+
+	for _, path := range paths {
+		b.WriteString(dumpIndent)
+		b.WriteString(path)
+		b.WriteByte('\n')
+	}
+
+	b.WriteByte('\n')
+}
+
+// pathDiffs describes where g and e differ. Mismatches at the root aren't
+// included: the line diff already says everything there is to say about them.
+func pathDiffs(g, e any, opts []EqualOption) []string {
+	const maxPaths = 16
+
+	var (
+		paths []string
+		more  int
+	)
+
+	// Formatting is expensive, so only do it for what's shown
+	for _, vd := range diffValues(g, e, opts) {
+		switch {
+		case vd.path == "":
+		case len(paths) < maxPaths:
+			paths = append(paths, vd.String())
+		default:
+			more++
+		}
+	}
+
+	if more > 0 {
+		paths = append(paths, fmt.Sprintf("... %d more differences ...", more))
+	}
+
+	return paths
 }
 
 func checkEqual(g, e any) (string, bool) {
+	g, e, msg := seqsForEqual(g, e)
+	if msg != "" {
+		return msg, false
+	}
+
 	if reflect.DeepEqual(g, e) {
 		return "", true
 	}
@@ -104,6 +427,11 @@ func checkEqual(g, e any) (string, bool) {
 }
 
 func checkNotEqual(g, e any) (string, bool) {
+	g, e, msg := seqsForEqual(g, e)
+	if msg != "" {
+		return msg, false
+	}
+
 	if reflect.DeepEqual(g, e) {
 		return "Expected values to differ:\n" + dump(g, 1), false
 	}
@@ -156,7 +484,8 @@ func checkErrIs(err, target error) (string, bool) {
 		return "", true
 	}
 
-	return equalMsg(err, target), false
+	title := "Expected error tree to contain target"
+	return errorMsg(title, "target", errorTree(target), err), false
 }
 
 func checkErrAs(err error, target any) (string, bool) {
@@ -164,7 +493,11 @@ func checkErrAs(err error, target any) (string, bool) {
 		return "", true
 	}
 
-	return equalMsg(err, target), false
+	msg := fmt.Sprintf(
+		"Expected error tree to contain a %s:\n",
+		reflect.TypeOf(target).Elem(),
+	)
+	return msg + indentErrorTree(err, 1), false
 }
 
 func containsMsg(container any, what string, el any) string {
@@ -242,8 +575,11 @@ func checkNotHasKey(m, k any) (string, bool) {
 	return notContainsMsg(m, "key", k), false
 }
 
-func contains(iter, v any) (msg, what string, ok bool) {
+// contains checks if iter contains v. show is what to dump for iter in any
+// failure message.
+func contains(iter, v any) (show any, msg, what string, ok bool) {
 	rv := reflect.ValueOf(iter)
+	show = iter
 
 	switch rv.Kind() {
 	case reflect.Map:
@@ -286,14 +622,37 @@ func contains(iter, v any) (msg, what string, ok bool) {
 
 		return
 
-	default:
-		msg = fmt.Sprintf("Cannot check non-container %T for containment", iter)
+	case reflect.Func:
+		if !isSeq(rv) {
+			break
+		}
+
+		what = "value"
+
+		// Seqs might not be re-runnable, so show what was seen
+		yt := rv.Type().In(0)
+		seen := reflect.MakeSlice(reflect.SliceOf(yt.In(yt.NumIn()-1)), 0, 0)
+
+		more := rangeSeq(rv, maxSeqLen, func(_, sv reflect.Value) bool {
+			seen = reflect.Append(seen, sv)
+			ok = reflect.DeepEqual(sv.Interface(), v)
+			return !ok
+		})
+		if more {
+			msg = seqTooLongMsg(iter)
+		}
+
+		show = seen.Interface()
+
 		return
 	}
+
+	msg = fmt.Sprintf("Cannot check non-container %T for containment", iter)
+	return
 }
 
 func checkContains(iter, v any) (string, bool) {
-	msg, what, ok := contains(iter, v)
+	show, msg, what, ok := contains(iter, v)
 	if msg != "" {
 		return msg, false
 	}
@@ -302,11 +661,11 @@ func checkContains(iter, v any) (string, bool) {
 		return "", true
 	}
 
-	return containsMsg(iter, what, v), false
+	return containsMsg(show, what, v), false
 }
 
 func checkNotContains(iter, v any) (string, bool) {
-	msg, what, ok := contains(iter, v)
+	show, msg, what, ok := contains(iter, v)
 	if msg != "" {
 		return msg, false
 	}
@@ -315,54 +674,46 @@ func checkNotContains(iter, v any) (string, bool) {
 		return "", true
 	}
 
-	return notContainsMsg(iter, what, v), false
+	return notContainsMsg(show, what, v), false
 }
 
-func checkPanics(fn func()) (msg string, ok bool) {
-	defer func() {
-		ok = recover() != nil
-	}()
+func checkPanics(fn func()) (string, bool) {
+	if _, _, panicked := recoverPanic(fn); panicked {
+		return "", true
+	}
 
-	fn()
 	return "Expected func to panic", false
 }
 
-func checkNotPanics(fn func()) (msg string, ok bool) {
-	defer func() {
-		if r := recover(); r != nil {
-			msg = "Expected func not to panic:\n" +
-				dump(r, 1) +
-				"\n" +
-				"\n" +
-				textwrap.Indent(string(debug.Stack()), dumpIndent)
-		}
-	}()
+func checkNotPanics(fn func()) (string, bool) {
+	r, stack, panicked := recoverPanic(fn)
+	if !panicked {
+		return "", true
+	}
 
-	fn()
-	return "", true
+	msg := "Expected func not to panic:\n" +
+		dump(r, 1) +
+		"\n" +
+		"\n" +
+		panicStack(stack)
+	return msg, false
 }
 
-func checkPanicsWith(recovers any, fn func()) (msg string, ok bool) {
-	defer func() {
-		r := recover()
-		if r == nil {
-			msg = "Expected func to panic"
-			return
-		}
-
-		if !reflect.DeepEqual(r, recovers) {
-			msg = equalMsg(r, recovers) +
-				"\n" +
-				"\n" +
-				textwrap.Indent(string(debug.Stack()), dumpIndent)
-			return
-		}
+func checkPanicsWith(recovers any, fn func()) (string, bool) {
+	r, stack, panicked := recoverPanic(fn)
+	if !panicked {
+		return "Expected func to panic", false
+	}
 
-		ok = true
-	}()
+	if !reflect.DeepEqual(r, recovers) {
+		msg := equalMsg(r, recovers) +
+			"\n" +
+			"\n" +
+			panicStack(stack)
+		return msg, false
+	}
 
-	fn()
-	return
+	return "", true
 }
 
 func checkEventuallyTrue(numTries int, fn func(i int) bool) (string, bool) {
@@ -4,7 +4,14 @@ package check
 
 //gocovr:skip-file
 
-import "fmt"
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thatguystone/cog/callstack"
+)
 
 // Check that the given bool is true.
 func True(t Error, cond bool) bool {
@@ -44,6 +51,44 @@ func MustTruef(t Fatal, cond bool, format string, args ...any) {
 	}
 }
 
+// Check that the given bool is true.
+func (c Checker) True(cond bool) bool {
+	if msg, ok := checkTrue(cond); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given bool is true.
+func (c Checker) Truef(cond bool, format string, args ...any) bool {
+	if msg, ok := checkTrue(cond); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given bool is true.
+func (c MustChecker) True(cond bool) {
+	if msg, ok := checkTrue(cond); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given bool is true.
+func (c MustChecker) Truef(cond bool, format string, args ...any) {
+	if msg, ok := checkTrue(cond); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
 // Check that the given bool is false.
 func False(t Error, cond bool) bool {
 	if msg, ok := checkFalse(cond); !ok {
@@ -82,7 +127,45 @@ func MustFalsef(t Fatal, cond bool, format string, args ...any) {
 	}
 }
 
-// Check that two things are equal; e is the expected value, g is what was got.
+// Check that the given bool is false.
+func (c Checker) False(cond bool) bool {
+	if msg, ok := checkFalse(cond); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given bool is false.
+func (c Checker) Falsef(cond bool, format string, args ...any) bool {
+	if msg, ok := checkFalse(cond); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given bool is false.
+func (c MustChecker) False(cond bool) {
+	if msg, ok := checkFalse(cond); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given bool is false.
+func (c MustChecker) Falsef(cond bool, format string, args ...any) {
+	if msg, ok := checkFalse(cond); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that two things are equal; e is the expected value, g is what was got. An iter.Seq is compared as a slice of the values it yields, and an iter.Seq2 as a map when compared to one.
 func Equal(t Error, g, e any) bool {
 	if msg, ok := checkEqual(g, e); !ok {
 		t.Helper()
@@ -93,7 +176,7 @@ func Equal(t Error, g, e any) bool {
 	return true
 }
 
-// Check that two things are equal; e is the expected value, g is what was got.
+// Check that two things are equal; e is the expected value, g is what was got. An iter.Seq is compared as a slice of the values it yields, and an iter.Seq2 as a map when compared to one.
 func Equalf(t Error, g, e any, format string, args ...any) bool {
 	if msg, ok := checkEqual(g, e); !ok {
 		t.Helper()
@@ -104,7 +187,7 @@ func Equalf(t Error, g, e any, format string, args ...any) bool {
 	return true
 }
 
-// Check that two things are equal; e is the expected value, g is what was got.
+// Check that two things are equal; e is the expected value, g is what was got. An iter.Seq is compared as a slice of the values it yields, and an iter.Seq2 as a map when compared to one.
 func MustEqual(t Fatal, g, e any) {
 	if msg, ok := checkEqual(g, e); !ok {
 		t.Helper()
@@ -112,7 +195,7 @@ func MustEqual(t Fatal, g, e any) {
 	}
 }
 
-// Check that two things are equal; e is the expected value, g is what was got.
+// Check that two things are equal; e is the expected value, g is what was got. An iter.Seq is compared as a slice of the values it yields, and an iter.Seq2 as a map when compared to one.
 func MustEqualf(t Fatal, g, e any, format string, args ...any) {
 	if msg, ok := checkEqual(g, e); !ok {
 		t.Helper()
@@ -120,47 +203,47 @@ func MustEqualf(t Fatal, g, e any, format string, args ...any) {
 	}
 }
 
-// Check that two things are not equal; e is the expected value, g is what was got.
-func NotEqual(t Error, g, e any) bool {
-	if msg, ok := checkNotEqual(g, e); !ok {
-		t.Helper()
-		t.Error("\n" + msg)
+// Check that two things are equal; e is the expected value, g is what was got. An iter.Seq is compared as a slice of the values it yields, and an iter.Seq2 as a map when compared to one.
+func (c Checker) Equal(g, e any) bool {
+	if msg, ok := checkEqual(g, e); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that two things are not equal; e is the expected value, g is what was got.
-func NotEqualf(t Error, g, e any, format string, args ...any) bool {
-	if msg, ok := checkNotEqual(g, e); !ok {
-		t.Helper()
-		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that two things are equal; e is the expected value, g is what was got. An iter.Seq is compared as a slice of the values it yields, and an iter.Seq2 as a map when compared to one.
+func (c Checker) Equalf(g, e any, format string, args ...any) bool {
+	if msg, ok := checkEqual(g, e); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that two things are not equal; e is the expected value, g is what was got.
-func MustNotEqual(t Fatal, g, e any) {
-	if msg, ok := checkNotEqual(g, e); !ok {
-		t.Helper()
-		t.Fatal("\n" + msg)
+// Check that two things are equal; e is the expected value, g is what was got. An iter.Seq is compared as a slice of the values it yields, and an iter.Seq2 as a map when compared to one.
+func (c MustChecker) Equal(g, e any) {
+	if msg, ok := checkEqual(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
 	}
 }
 
-// Check that two things are not equal; e is the expected value, g is what was got.
-func MustNotEqualf(t Fatal, g, e any, format string, args ...any) {
-	if msg, ok := checkNotEqual(g, e); !ok {
-		t.Helper()
-		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that two things are equal; e is the expected value, g is what was got. An iter.Seq is compared as a slice of the values it yields, and an iter.Seq2 as a map when compared to one.
+func (c MustChecker) Equalf(g, e any, format string, args ...any) {
+	if msg, ok := checkEqual(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that v is nil. This is a strict equality check.
-func Nil(t Error, v any) bool {
-	if msg, ok := checkNil(v); !ok {
+// Check that two things of the same type are equal; e is the expected value, g is what was got. This is a type-safe version of [Equal].
+func EqualT[T any](t Error, g, e T) bool {
+	if msg, ok := checkEqual(g, e); !ok {
 		t.Helper()
 		t.Error("\n" + msg)
 		return false
@@ -169,9 +252,9 @@ func Nil(t Error, v any) bool {
 	return true
 }
 
-// Check that v is nil. This is a strict equality check.
-func Nilf(t Error, v any, format string, args ...any) bool {
-	if msg, ok := checkNil(v); !ok {
+// Check that two things of the same type are equal; e is the expected value, g is what was got. This is a type-safe version of [Equal].
+func EqualTf[T any](t Error, g, e T, format string, args ...any) bool {
+	if msg, ok := checkEqual(g, e); !ok {
 		t.Helper()
 		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
@@ -180,25 +263,25 @@ func Nilf(t Error, v any, format string, args ...any) bool {
 	return true
 }
 
-// Check that v is nil. This is a strict equality check.
-func MustNil(t Fatal, v any) {
-	if msg, ok := checkNil(v); !ok {
+// Check that two things of the same type are equal; e is the expected value, g is what was got. This is a type-safe version of [Equal].
+func MustEqualT[T any](t Fatal, g, e T) {
+	if msg, ok := checkEqual(g, e); !ok {
 		t.Helper()
 		t.Fatal("\n" + msg)
 	}
 }
 
-// Check that v is nil. This is a strict equality check.
-func MustNilf(t Fatal, v any, format string, args ...any) {
-	if msg, ok := checkNil(v); !ok {
+// Check that two things of the same type are equal; e is the expected value, g is what was got. This is a type-safe version of [Equal].
+func MustEqualTf[T any](t Fatal, g, e T, format string, args ...any) {
+	if msg, ok := checkEqual(g, e); !ok {
 		t.Helper()
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that v is not nil. This is a strict equality check.
-func NotNil(t Error, v any) bool {
-	if msg, ok := checkNotNil(v); !ok {
+// Check that two things are equal, as customized by opts; e is the expected value, g is what was got.
+func EqualWith(t Error, g, e any, opts ...EqualOption) bool {
+	if msg, ok := checkEqualWith(g, e, opts...); !ok {
 		t.Helper()
 		t.Error("\n" + msg)
 		return false
@@ -207,9 +290,9 @@ func NotNil(t Error, v any) bool {
 	return true
 }
 
-// Check that v is not nil. This is a strict equality check.
-func NotNilf(t Error, v any, format string, args ...any) bool {
-	if msg, ok := checkNotNil(v); !ok {
+// Check that two things are equal, as customized by opts; e is the expected value, g is what was got.
+func EqualWithf(t Error, g, e any, opts []EqualOption, format string, args ...any) bool {
+	if msg, ok := checkEqualWith(g, e, opts...); !ok {
 		t.Helper()
 		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
@@ -218,63 +301,63 @@ func NotNilf(t Error, v any, format string, args ...any) bool {
 	return true
 }
 
-// Check that v is not nil. This is a strict equality check.
-func MustNotNil(t Fatal, v any) {
-	if msg, ok := checkNotNil(v); !ok {
+// Check that two things are equal, as customized by opts; e is the expected value, g is what was got.
+func MustEqualWith(t Fatal, g, e any, opts ...EqualOption) {
+	if msg, ok := checkEqualWith(g, e, opts...); !ok {
 		t.Helper()
 		t.Fatal("\n" + msg)
 	}
 }
 
-// Check that v is not nil. This is a strict equality check.
-func MustNotNilf(t Fatal, v any, format string, args ...any) {
-	if msg, ok := checkNotNil(v); !ok {
+// Check that two things are equal, as customized by opts; e is the expected value, g is what was got.
+func MustEqualWithf(t Fatal, g, e any, opts []EqualOption, format string, args ...any) {
+	if msg, ok := checkEqualWith(g, e, opts...); !ok {
 		t.Helper()
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that v is the zero value for its type.
-func Zero(t Error, v any) bool {
-	if msg, ok := checkZero(v); !ok {
-		t.Helper()
-		t.Error("\n" + msg)
+// Check that two things are equal, as customized by opts; e is the expected value, g is what was got.
+func (c Checker) EqualWith(g, e any, opts ...EqualOption) bool {
+	if msg, ok := checkEqualWith(g, e, opts...); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that v is the zero value for its type.
-func Zerof(t Error, v any, format string, args ...any) bool {
-	if msg, ok := checkZero(v); !ok {
-		t.Helper()
-		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that two things are equal, as customized by opts; e is the expected value, g is what was got.
+func (c Checker) EqualWithf(g, e any, opts []EqualOption, format string, args ...any) bool {
+	if msg, ok := checkEqualWith(g, e, opts...); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that v is the zero value for its type.
-func MustZero(t Fatal, v any) {
-	if msg, ok := checkZero(v); !ok {
-		t.Helper()
-		t.Fatal("\n" + msg)
+// Check that two things are equal, as customized by opts; e is the expected value, g is what was got.
+func (c MustChecker) EqualWith(g, e any, opts ...EqualOption) {
+	if msg, ok := checkEqualWith(g, e, opts...); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
 	}
 }
 
-// Check that v is the zero value for its type.
-func MustZerof(t Fatal, v any, format string, args ...any) {
-	if msg, ok := checkZero(v); !ok {
-		t.Helper()
-		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that two things are equal, as customized by opts; e is the expected value, g is what was got.
+func (c MustChecker) EqualWithf(g, e any, opts []EqualOption, format string, args ...any) {
+	if msg, ok := checkEqualWith(g, e, opts...); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that v is not the zero value for its type.
-func NotZero(t Error, v any) bool {
-	if msg, ok := checkNotZero(v); !ok {
+// Check that g matches pattern, which is usually a partially filled-in value of the same type. Zero values in pattern match anything, maps in pattern only need to be a subset of those in g, and fields can be explicitly skipped with [IgnoreFields]. Other opts customize the comparison as in [EqualWith].
+func Match(t Error, g, pattern any, opts ...EqualOption) bool {
+	if msg, ok := checkMatch(g, pattern, opts...); !ok {
 		t.Helper()
 		t.Error("\n" + msg)
 		return false
@@ -283,9 +366,9 @@ func NotZero(t Error, v any) bool {
 	return true
 }
 
-// Check that v is not the zero value for its type.
-func NotZerof(t Error, v any, format string, args ...any) bool {
-	if msg, ok := checkNotZero(v); !ok {
+// Check that g matches pattern, which is usually a partially filled-in value of the same type. Zero values in pattern match anything, maps in pattern only need to be a subset of those in g, and fields can be explicitly skipped with [IgnoreFields]. Other opts customize the comparison as in [EqualWith].
+func Matchf(t Error, g, pattern any, opts []EqualOption, format string, args ...any) bool {
+	if msg, ok := checkMatch(g, pattern, opts...); !ok {
 		t.Helper()
 		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
@@ -294,63 +377,63 @@ func NotZerof(t Error, v any, format string, args ...any) bool {
 	return true
 }
 
-// Check that v is not the zero value for its type.
-func MustNotZero(t Fatal, v any) {
-	if msg, ok := checkNotZero(v); !ok {
+// Check that g matches pattern, which is usually a partially filled-in value of the same type. Zero values in pattern match anything, maps in pattern only need to be a subset of those in g, and fields can be explicitly skipped with [IgnoreFields]. Other opts customize the comparison as in [EqualWith].
+func MustMatch(t Fatal, g, pattern any, opts ...EqualOption) {
+	if msg, ok := checkMatch(g, pattern, opts...); !ok {
 		t.Helper()
 		t.Fatal("\n" + msg)
 	}
 }
 
-// Check that v is not the zero value for its type.
-func MustNotZerof(t Fatal, v any, format string, args ...any) {
-	if msg, ok := checkNotZero(v); !ok {
+// Check that g matches pattern, which is usually a partially filled-in value of the same type. Zero values in pattern match anything, maps in pattern only need to be a subset of those in g, and fields can be explicitly skipped with [IgnoreFields]. Other opts customize the comparison as in [EqualWith].
+func MustMatchf(t Fatal, g, pattern any, opts []EqualOption, format string, args ...any) {
+	if msg, ok := checkMatch(g, pattern, opts...); !ok {
 		t.Helper()
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that [errors.Is] returns true.
-func ErrIs(t Error, err, target error) bool {
-	if msg, ok := checkErrIs(err, target); !ok {
-		t.Helper()
-		t.Error("\n" + msg)
+// Check that g matches pattern, which is usually a partially filled-in value of the same type. Zero values in pattern match anything, maps in pattern only need to be a subset of those in g, and fields can be explicitly skipped with [IgnoreFields]. Other opts customize the comparison as in [EqualWith].
+func (c Checker) Match(g, pattern any, opts ...EqualOption) bool {
+	if msg, ok := checkMatch(g, pattern, opts...); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that [errors.Is] returns true.
-func ErrIsf(t Error, err, target error, format string, args ...any) bool {
-	if msg, ok := checkErrIs(err, target); !ok {
-		t.Helper()
-		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that g matches pattern, which is usually a partially filled-in value of the same type. Zero values in pattern match anything, maps in pattern only need to be a subset of those in g, and fields can be explicitly skipped with [IgnoreFields]. Other opts customize the comparison as in [EqualWith].
+func (c Checker) Matchf(g, pattern any, opts []EqualOption, format string, args ...any) bool {
+	if msg, ok := checkMatch(g, pattern, opts...); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that [errors.Is] returns true.
-func MustErrIs(t Fatal, err, target error) {
-	if msg, ok := checkErrIs(err, target); !ok {
-		t.Helper()
-		t.Fatal("\n" + msg)
+// Check that g matches pattern, which is usually a partially filled-in value of the same type. Zero values in pattern match anything, maps in pattern only need to be a subset of those in g, and fields can be explicitly skipped with [IgnoreFields]. Other opts customize the comparison as in [EqualWith].
+func (c MustChecker) Match(g, pattern any, opts ...EqualOption) {
+	if msg, ok := checkMatch(g, pattern, opts...); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
 	}
 }
 
-// Check that [errors.Is] returns true.
-func MustErrIsf(t Fatal, err, target error, format string, args ...any) {
-	if msg, ok := checkErrIs(err, target); !ok {
-		t.Helper()
-		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that g matches pattern, which is usually a partially filled-in value of the same type. Zero values in pattern match anything, maps in pattern only need to be a subset of those in g, and fields can be explicitly skipped with [IgnoreFields]. Other opts customize the comparison as in [EqualWith].
+func (c MustChecker) Matchf(g, pattern any, opts []EqualOption, format string, args ...any) {
+	if msg, ok := checkMatch(g, pattern, opts...); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that [errors.As] returns true.
-func ErrAs(t Error, err error, target any) bool {
-	if msg, ok := checkErrAs(err, target); !ok {
+// Check that v is matched by m.
+func That(t Error, v any, m Matcher) bool {
+	if msg, ok := checkThat(v, m); !ok {
 		t.Helper()
 		t.Error("\n" + msg)
 		return false
@@ -359,9 +442,9 @@ func ErrAs(t Error, err error, target any) bool {
 	return true
 }
 
-// Check that [errors.As] returns true.
-func ErrAsf(t Error, err error, target any, format string, args ...any) bool {
-	if msg, ok := checkErrAs(err, target); !ok {
+// Check that v is matched by m.
+func Thatf(t Error, v any, m Matcher, format string, args ...any) bool {
+	if msg, ok := checkThat(v, m); !ok {
 		t.Helper()
 		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
@@ -370,63 +453,63 @@ func ErrAsf(t Error, err error, target any, format string, args ...any) bool {
 	return true
 }
 
-// Check that [errors.As] returns true.
-func MustErrAs(t Fatal, err error, target any) {
-	if msg, ok := checkErrAs(err, target); !ok {
+// Check that v is matched by m.
+func MustThat(t Fatal, v any, m Matcher) {
+	if msg, ok := checkThat(v, m); !ok {
 		t.Helper()
 		t.Fatal("\n" + msg)
 	}
 }
 
-// Check that [errors.As] returns true.
-func MustErrAsf(t Fatal, err error, target any, format string, args ...any) {
-	if msg, ok := checkErrAs(err, target); !ok {
+// Check that v is matched by m.
+func MustThatf(t Fatal, v any, m Matcher, format string, args ...any) {
+	if msg, ok := checkThat(v, m); !ok {
 		t.Helper()
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that map m contains key k.
-func HasKey(t Error, m, k any) bool {
-	if msg, ok := checkHasKey(m, k); !ok {
-		t.Helper()
-		t.Error("\n" + msg)
+// Check that v is matched by m.
+func (c Checker) That(v any, m Matcher) bool {
+	if msg, ok := checkThat(v, m); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that map m contains key k.
-func HasKeyf(t Error, m, k any, format string, args ...any) bool {
-	if msg, ok := checkHasKey(m, k); !ok {
-		t.Helper()
-		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that v is matched by m.
+func (c Checker) Thatf(v any, m Matcher, format string, args ...any) bool {
+	if msg, ok := checkThat(v, m); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that map m contains key k.
-func MustHaveKey(t Fatal, m, k any) {
-	if msg, ok := checkHasKey(m, k); !ok {
-		t.Helper()
-		t.Fatal("\n" + msg)
+// Check that v is matched by m.
+func (c MustChecker) That(v any, m Matcher) {
+	if msg, ok := checkThat(v, m); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
 	}
 }
 
-// Check that map m contains key k.
-func MustHaveKeyf(t Fatal, m, k any, format string, args ...any) {
-	if msg, ok := checkHasKey(m, k); !ok {
-		t.Helper()
-		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that v is matched by m.
+func (c MustChecker) Thatf(v any, m Matcher, format string, args ...any) {
+	if msg, ok := checkThat(v, m); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that map m does not contain key k.
-func NotHasKey(t Error, m, k any) bool {
-	if msg, ok := checkNotHasKey(m, k); !ok {
+// Check that g and e are semantically equal JSON, ignoring object key order, whitespace, and number formatting. Strings, []byte, and [json.RawMessage] are parsed as JSON; anything else is marshaled first.
+func JSONEq(t Error, g, e any) bool {
+	if msg, ok := checkJSONEq(g, e); !ok {
 		t.Helper()
 		t.Error("\n" + msg)
 		return false
@@ -435,9 +518,9 @@ func NotHasKey(t Error, m, k any) bool {
 	return true
 }
 
-// Check that map m does not contain key k.
-func NotHasKeyf(t Error, m, k any, format string, args ...any) bool {
-	if msg, ok := checkNotHasKey(m, k); !ok {
+// Check that g and e are semantically equal JSON, ignoring object key order, whitespace, and number formatting. Strings, []byte, and [json.RawMessage] are parsed as JSON; anything else is marshaled first.
+func JSONEqf(t Error, g, e any, format string, args ...any) bool {
+	if msg, ok := checkJSONEq(g, e); !ok {
 		t.Helper()
 		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
@@ -446,63 +529,63 @@ func NotHasKeyf(t Error, m, k any, format string, args ...any) bool {
 	return true
 }
 
-// Check that map m does not contain key k.
-func MustNotHaveKey(t Fatal, m, k any) {
-	if msg, ok := checkNotHasKey(m, k); !ok {
+// Check that g and e are semantically equal JSON, ignoring object key order, whitespace, and number formatting. Strings, []byte, and [json.RawMessage] are parsed as JSON; anything else is marshaled first.
+func MustJSONEq(t Fatal, g, e any) {
+	if msg, ok := checkJSONEq(g, e); !ok {
 		t.Helper()
 		t.Fatal("\n" + msg)
 	}
 }
 
-// Check that map m does not contain key k.
-func MustNotHaveKeyf(t Fatal, m, k any, format string, args ...any) {
-	if msg, ok := checkNotHasKey(m, k); !ok {
+// Check that g and e are semantically equal JSON, ignoring object key order, whitespace, and number formatting. Strings, []byte, and [json.RawMessage] are parsed as JSON; anything else is marshaled first.
+func MustJSONEqf(t Fatal, g, e any, format string, args ...any) {
+	if msg, ok := checkJSONEq(g, e); !ok {
 		t.Helper()
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that iter contains value v. Iter must be one of: map, slice, array, or string.
-func Contains(t Error, iter, v any) bool {
-	if msg, ok := checkContains(iter, v); !ok {
-		t.Helper()
-		t.Error("\n" + msg)
+// Check that g and e are semantically equal JSON, ignoring object key order, whitespace, and number formatting. Strings, []byte, and [json.RawMessage] are parsed as JSON; anything else is marshaled first.
+func (c Checker) JSONEq(g, e any) bool {
+	if msg, ok := checkJSONEq(g, e); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that iter contains value v. Iter must be one of: map, slice, array, or string.
-func Containsf(t Error, iter, v any, format string, args ...any) bool {
-	if msg, ok := checkContains(iter, v); !ok {
-		t.Helper()
-		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that g and e are semantically equal JSON, ignoring object key order, whitespace, and number formatting. Strings, []byte, and [json.RawMessage] are parsed as JSON; anything else is marshaled first.
+func (c Checker) JSONEqf(g, e any, format string, args ...any) bool {
+	if msg, ok := checkJSONEq(g, e); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that iter contains value v. Iter must be one of: map, slice, array, or string.
-func MustContain(t Fatal, iter, v any) {
-	if msg, ok := checkContains(iter, v); !ok {
-		t.Helper()
-		t.Fatal("\n" + msg)
+// Check that g and e are semantically equal JSON, ignoring object key order, whitespace, and number formatting. Strings, []byte, and [json.RawMessage] are parsed as JSON; anything else is marshaled first.
+func (c MustChecker) JSONEq(g, e any) {
+	if msg, ok := checkJSONEq(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
 	}
 }
 
-// Check that iter contains value v. Iter must be one of: map, slice, array, or string.
-func MustContainf(t Fatal, iter, v any, format string, args ...any) {
-	if msg, ok := checkContains(iter, v); !ok {
-		t.Helper()
-		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that g and e are semantically equal JSON, ignoring object key order, whitespace, and number formatting. Strings, []byte, and [json.RawMessage] are parsed as JSON; anything else is marshaled first.
+func (c MustChecker) JSONEqf(g, e any, format string, args ...any) {
+	if msg, ok := checkJSONEq(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that iter does not contain value v. Iter must be one of: map, slice, array, or string
-func NotContains(t Error, iter, v any) bool {
-	if msg, ok := checkNotContains(iter, v); !ok {
+// Check that two things are not equal; e is the expected value, g is what was got.
+func NotEqual(t Error, g, e any) bool {
+	if msg, ok := checkNotEqual(g, e); !ok {
 		t.Helper()
 		t.Error("\n" + msg)
 		return false
@@ -511,9 +594,9 @@ func NotContains(t Error, iter, v any) bool {
 	return true
 }
 
-// Check that iter does not contain value v. Iter must be one of: map, slice, array, or string
-func NotContainsf(t Error, iter, v any, format string, args ...any) bool {
-	if msg, ok := checkNotContains(iter, v); !ok {
+// Check that two things are not equal; e is the expected value, g is what was got.
+func NotEqualf(t Error, g, e any, format string, args ...any) bool {
+	if msg, ok := checkNotEqual(g, e); !ok {
 		t.Helper()
 		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
@@ -522,63 +605,3241 @@ func NotContainsf(t Error, iter, v any, format string, args ...any) bool {
 	return true
 }
 
-// Check that iter does not contain value v. Iter must be one of: map, slice, array, or string
-func MustNotContain(t Fatal, iter, v any) {
-	if msg, ok := checkNotContains(iter, v); !ok {
+// Check that two things are not equal; e is the expected value, g is what was got.
+func MustNotEqual(t Fatal, g, e any) {
+	if msg, ok := checkNotEqual(g, e); !ok {
 		t.Helper()
 		t.Fatal("\n" + msg)
 	}
 }
 
-// Check that iter does not contain value v. Iter must be one of: map, slice, array, or string
-func MustNotContainf(t Fatal, iter, v any, format string, args ...any) {
-	if msg, ok := checkNotContains(iter, v); !ok {
+// Check that two things are not equal; e is the expected value, g is what was got.
+func MustNotEqualf(t Fatal, g, e any, format string, args ...any) {
+	if msg, ok := checkNotEqual(g, e); !ok {
 		t.Helper()
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that the given function panics.
-func Panics(t Error, fn func()) bool {
-	if msg, ok := checkPanics(fn); !ok {
-		t.Helper()
-		t.Error("\n" + msg)
+// Check that two things are not equal; e is the expected value, g is what was got.
+func (c Checker) NotEqual(g, e any) bool {
+	if msg, ok := checkNotEqual(g, e); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that the given function panics.
-func Panicsf(t Error, fn func(), format string, args ...any) bool {
-	if msg, ok := checkPanics(fn); !ok {
-		t.Helper()
-		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+// Check that two things are not equal; e is the expected value, g is what was got.
+func (c Checker) NotEqualf(g, e any, format string, args ...any) bool {
+	if msg, ok := checkNotEqual(g, e); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
 	}
 
 	return true
 }
 
-// Check that the given function panics.
-func MustPanic(t Fatal, fn func()) {
-	if msg, ok := checkPanics(fn); !ok {
-		t.Helper()
-		t.Fatal("\n" + msg)
+// Check that two things are not equal; e is the expected value, g is what was got.
+func (c MustChecker) NotEqual(g, e any) {
+	if msg, ok := checkNotEqual(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that two things are not equal; e is the expected value, g is what was got.
+func (c MustChecker) NotEqualf(g, e any, format string, args ...any) {
+	if msg, ok := checkNotEqual(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that two things of the same type are not equal; e is the expected value, g is what was got. This is a type-safe version of [NotEqual].
+func NotEqualT[T any](t Error, g, e T) bool {
+	if msg, ok := checkNotEqual(g, e); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that two things of the same type are not equal; e is the expected value, g is what was got. This is a type-safe version of [NotEqual].
+func NotEqualTf[T any](t Error, g, e T, format string, args ...any) bool {
+	if msg, ok := checkNotEqual(g, e); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that two things of the same type are not equal; e is the expected value, g is what was got. This is a type-safe version of [NotEqual].
+func MustNotEqualT[T any](t Fatal, g, e T) {
+	if msg, ok := checkNotEqual(g, e); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that two things of the same type are not equal; e is the expected value, g is what was got. This is a type-safe version of [NotEqual].
+func MustNotEqualTf[T any](t Fatal, g, e T, format string, args ...any) {
+	if msg, ok := checkNotEqual(g, e); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that a < b.
+func Less[T cmp.Ordered](t Error, a, b T) bool {
+	if msg, ok := checkLess(a, b); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a < b.
+func Lessf[T cmp.Ordered](t Error, a, b T, format string, args ...any) bool {
+	if msg, ok := checkLess(a, b); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a < b.
+func MustLess[T cmp.Ordered](t Fatal, a, b T) {
+	if msg, ok := checkLess(a, b); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that a < b.
+func MustLessf[T cmp.Ordered](t Fatal, a, b T, format string, args ...any) {
+	if msg, ok := checkLess(a, b); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that a <= b.
+func LessOrEqual[T cmp.Ordered](t Error, a, b T) bool {
+	if msg, ok := checkLessOrEqual(a, b); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a <= b.
+func LessOrEqualf[T cmp.Ordered](t Error, a, b T, format string, args ...any) bool {
+	if msg, ok := checkLessOrEqual(a, b); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a <= b.
+func MustLessOrEqual[T cmp.Ordered](t Fatal, a, b T) {
+	if msg, ok := checkLessOrEqual(a, b); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that a <= b.
+func MustLessOrEqualf[T cmp.Ordered](t Fatal, a, b T, format string, args ...any) {
+	if msg, ok := checkLessOrEqual(a, b); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that a > b.
+func Greater[T cmp.Ordered](t Error, a, b T) bool {
+	if msg, ok := checkGreater(a, b); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a > b.
+func Greaterf[T cmp.Ordered](t Error, a, b T, format string, args ...any) bool {
+	if msg, ok := checkGreater(a, b); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a > b.
+func MustGreater[T cmp.Ordered](t Fatal, a, b T) {
+	if msg, ok := checkGreater(a, b); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that a > b.
+func MustGreaterf[T cmp.Ordered](t Fatal, a, b T, format string, args ...any) {
+	if msg, ok := checkGreater(a, b); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that a >= b.
+func GreaterOrEqual[T cmp.Ordered](t Error, a, b T) bool {
+	if msg, ok := checkGreaterOrEqual(a, b); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a >= b.
+func GreaterOrEqualf[T cmp.Ordered](t Error, a, b T, format string, args ...any) bool {
+	if msg, ok := checkGreaterOrEqual(a, b); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a >= b.
+func MustGreaterOrEqual[T cmp.Ordered](t Fatal, a, b T) {
+	if msg, ok := checkGreaterOrEqual(a, b); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that a >= b.
+func MustGreaterOrEqualf[T cmp.Ordered](t Fatal, a, b T, format string, args ...any) {
+	if msg, ok := checkGreaterOrEqual(a, b); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is greater than the zero value of T.
+func Positive[T cmp.Ordered](t Error, v T) bool {
+	if msg, ok := checkPositive(v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is greater than the zero value of T.
+func Positivef[T cmp.Ordered](t Error, v T, format string, args ...any) bool {
+	if msg, ok := checkPositive(v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is greater than the zero value of T.
+func MustPositive[T cmp.Ordered](t Fatal, v T) {
+	if msg, ok := checkPositive(v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is greater than the zero value of T.
+func MustPositivef[T cmp.Ordered](t Fatal, v T, format string, args ...any) {
+	if msg, ok := checkPositive(v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is less than the zero value of T.
+func Negative[T cmp.Ordered](t Error, v T) bool {
+	if msg, ok := checkNegative(v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is less than the zero value of T.
+func Negativef[T cmp.Ordered](t Error, v T, format string, args ...any) bool {
+	if msg, ok := checkNegative(v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is less than the zero value of T.
+func MustNegative[T cmp.Ordered](t Fatal, v T) {
+	if msg, ok := checkNegative(v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is less than the zero value of T.
+func MustNegativef[T cmp.Ordered](t Fatal, v T, format string, args ...any) {
+	if msg, ok := checkNegative(v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that lo <= v <= hi.
+func Between[T cmp.Ordered](t Error, v, lo, hi T) bool {
+	if msg, ok := checkBetween(v, lo, hi); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that lo <= v <= hi.
+func Betweenf[T cmp.Ordered](t Error, v, lo, hi T, format string, args ...any) bool {
+	if msg, ok := checkBetween(v, lo, hi); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that lo <= v <= hi.
+func MustBetween[T cmp.Ordered](t Fatal, v, lo, hi T) {
+	if msg, ok := checkBetween(v, lo, hi); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that lo <= v <= hi.
+func MustBetweenf[T cmp.Ordered](t Fatal, v, lo, hi T, format string, args ...any) {
+	if msg, ok := checkBetween(v, lo, hi); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that g and e differ by no more than delta; e is the expected value, g is what was got.
+func InDelta(t Error, g, e, delta float64) bool {
+	if msg, ok := checkInDelta(g, e, delta); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that g and e differ by no more than delta; e is the expected value, g is what was got.
+func InDeltaf(t Error, g, e, delta float64, format string, args ...any) bool {
+	if msg, ok := checkInDelta(g, e, delta); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that g and e differ by no more than delta; e is the expected value, g is what was got.
+func MustInDelta(t Fatal, g, e, delta float64) {
+	if msg, ok := checkInDelta(g, e, delta); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that g and e differ by no more than delta; e is the expected value, g is what was got.
+func MustInDeltaf(t Fatal, g, e, delta float64, format string, args ...any) {
+	if msg, ok := checkInDelta(g, e, delta); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that g and e differ by no more than delta; e is the expected value, g is what was got.
+func (c Checker) InDelta(g, e, delta float64) bool {
+	if msg, ok := checkInDelta(g, e, delta); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that g and e differ by no more than delta; e is the expected value, g is what was got.
+func (c Checker) InDeltaf(g, e, delta float64, format string, args ...any) bool {
+	if msg, ok := checkInDelta(g, e, delta); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that g and e differ by no more than delta; e is the expected value, g is what was got.
+func (c MustChecker) InDelta(g, e, delta float64) {
+	if msg, ok := checkInDelta(g, e, delta); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that g and e differ by no more than delta; e is the expected value, g is what was got.
+func (c MustChecker) InDeltaf(g, e, delta float64, format string, args ...any) {
+	if msg, ok := checkInDelta(g, e, delta); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the relative error between g and e, |g-e| / |e|, is no more than epsilon; e is the expected value, g is what was got.
+func InEpsilon(t Error, g, e, epsilon float64) bool {
+	if msg, ok := checkInEpsilon(g, e, epsilon); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the relative error between g and e, |g-e| / |e|, is no more than epsilon; e is the expected value, g is what was got.
+func InEpsilonf(t Error, g, e, epsilon float64, format string, args ...any) bool {
+	if msg, ok := checkInEpsilon(g, e, epsilon); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the relative error between g and e, |g-e| / |e|, is no more than epsilon; e is the expected value, g is what was got.
+func MustInEpsilon(t Fatal, g, e, epsilon float64) {
+	if msg, ok := checkInEpsilon(g, e, epsilon); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the relative error between g and e, |g-e| / |e|, is no more than epsilon; e is the expected value, g is what was got.
+func MustInEpsilonf(t Fatal, g, e, epsilon float64, format string, args ...any) {
+	if msg, ok := checkInEpsilon(g, e, epsilon); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the relative error between g and e, |g-e| / |e|, is no more than epsilon; e is the expected value, g is what was got.
+func (c Checker) InEpsilon(g, e, epsilon float64) bool {
+	if msg, ok := checkInEpsilon(g, e, epsilon); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the relative error between g and e, |g-e| / |e|, is no more than epsilon; e is the expected value, g is what was got.
+func (c Checker) InEpsilonf(g, e, epsilon float64, format string, args ...any) bool {
+	if msg, ok := checkInEpsilon(g, e, epsilon); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the relative error between g and e, |g-e| / |e|, is no more than epsilon; e is the expected value, g is what was got.
+func (c MustChecker) InEpsilon(g, e, epsilon float64) {
+	if msg, ok := checkInEpsilon(g, e, epsilon); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the relative error between g and e, |g-e| / |e|, is no more than epsilon; e is the expected value, g is what was got.
+func (c MustChecker) InEpsilonf(g, e, epsilon float64, format string, args ...any) {
+	if msg, ok := checkInEpsilon(g, e, epsilon); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is nil. This is a strict equality check.
+func Nil(t Error, v any) bool {
+	if msg, ok := checkNil(v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is nil. This is a strict equality check.
+func Nilf(t Error, v any, format string, args ...any) bool {
+	if msg, ok := checkNil(v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is nil. This is a strict equality check.
+func MustNil(t Fatal, v any) {
+	if msg, ok := checkNil(v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is nil. This is a strict equality check.
+func MustNilf(t Fatal, v any, format string, args ...any) {
+	if msg, ok := checkNil(v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is nil. This is a strict equality check.
+func (c Checker) Nil(v any) bool {
+	if msg, ok := checkNil(v); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is nil. This is a strict equality check.
+func (c Checker) Nilf(v any, format string, args ...any) bool {
+	if msg, ok := checkNil(v); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is nil. This is a strict equality check.
+func (c MustChecker) Nil(v any) {
+	if msg, ok := checkNil(v); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is nil. This is a strict equality check.
+func (c MustChecker) Nilf(v any, format string, args ...any) {
+	if msg, ok := checkNil(v); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is not nil. This is a strict equality check.
+func NotNil(t Error, v any) bool {
+	if msg, ok := checkNotNil(v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is not nil. This is a strict equality check.
+func NotNilf(t Error, v any, format string, args ...any) bool {
+	if msg, ok := checkNotNil(v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is not nil. This is a strict equality check.
+func MustNotNil(t Fatal, v any) {
+	if msg, ok := checkNotNil(v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is not nil. This is a strict equality check.
+func MustNotNilf(t Fatal, v any, format string, args ...any) {
+	if msg, ok := checkNotNil(v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is not nil. This is a strict equality check.
+func (c Checker) NotNil(v any) bool {
+	if msg, ok := checkNotNil(v); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is not nil. This is a strict equality check.
+func (c Checker) NotNilf(v any, format string, args ...any) bool {
+	if msg, ok := checkNotNil(v); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is not nil. This is a strict equality check.
+func (c MustChecker) NotNil(v any) {
+	if msg, ok := checkNotNil(v); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is not nil. This is a strict equality check.
+func (c MustChecker) NotNilf(v any, format string, args ...any) {
+	if msg, ok := checkNotNil(v); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is the zero value for its type.
+func Zero(t Error, v any) bool {
+	if msg, ok := checkZero(v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is the zero value for its type.
+func Zerof(t Error, v any, format string, args ...any) bool {
+	if msg, ok := checkZero(v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is the zero value for its type.
+func MustZero(t Fatal, v any) {
+	if msg, ok := checkZero(v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is the zero value for its type.
+func MustZerof(t Fatal, v any, format string, args ...any) {
+	if msg, ok := checkZero(v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is the zero value for its type.
+func (c Checker) Zero(v any) bool {
+	if msg, ok := checkZero(v); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is the zero value for its type.
+func (c Checker) Zerof(v any, format string, args ...any) bool {
+	if msg, ok := checkZero(v); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is the zero value for its type.
+func (c MustChecker) Zero(v any) {
+	if msg, ok := checkZero(v); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is the zero value for its type.
+func (c MustChecker) Zerof(v any, format string, args ...any) {
+	if msg, ok := checkZero(v); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is not the zero value for its type.
+func NotZero(t Error, v any) bool {
+	if msg, ok := checkNotZero(v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is not the zero value for its type.
+func NotZerof(t Error, v any, format string, args ...any) bool {
+	if msg, ok := checkNotZero(v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is not the zero value for its type.
+func MustNotZero(t Fatal, v any) {
+	if msg, ok := checkNotZero(v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is not the zero value for its type.
+func MustNotZerof(t Fatal, v any, format string, args ...any) {
+	if msg, ok := checkNotZero(v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that v is not the zero value for its type.
+func (c Checker) NotZero(v any) bool {
+	if msg, ok := checkNotZero(v); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is not the zero value for its type.
+func (c Checker) NotZerof(v any, format string, args ...any) bool {
+	if msg, ok := checkNotZero(v); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that v is not the zero value for its type.
+func (c MustChecker) NotZero(v any) {
+	if msg, ok := checkNotZero(v); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that v is not the zero value for its type.
+func (c MustChecker) NotZerof(v any, format string, args ...any) {
+	if msg, ok := checkNotZero(v); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that [errors.Is] returns true.
+func ErrIs(t Error, err, target error) bool {
+	if msg, ok := checkErrIs(err, target); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.Is] returns true.
+func ErrIsf(t Error, err, target error, format string, args ...any) bool {
+	if msg, ok := checkErrIs(err, target); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.Is] returns true.
+func MustErrIs(t Fatal, err, target error) {
+	if msg, ok := checkErrIs(err, target); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that [errors.Is] returns true.
+func MustErrIsf(t Fatal, err, target error, format string, args ...any) {
+	if msg, ok := checkErrIs(err, target); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that [errors.Is] returns true.
+func (c Checker) ErrIs(err, target error) bool {
+	if msg, ok := checkErrIs(err, target); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.Is] returns true.
+func (c Checker) ErrIsf(err, target error, format string, args ...any) bool {
+	if msg, ok := checkErrIs(err, target); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.Is] returns true.
+func (c MustChecker) ErrIs(err, target error) {
+	if msg, ok := checkErrIs(err, target); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that [errors.Is] returns true.
+func (c MustChecker) ErrIsf(err, target error, format string, args ...any) {
+	if msg, ok := checkErrIs(err, target); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that [errors.As] returns true.
+func ErrAs(t Error, err error, target any) bool {
+	if msg, ok := checkErrAs(err, target); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.As] returns true.
+func ErrAsf(t Error, err error, target any, format string, args ...any) bool {
+	if msg, ok := checkErrAs(err, target); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.As] returns true.
+func MustErrAs(t Fatal, err error, target any) {
+	if msg, ok := checkErrAs(err, target); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that [errors.As] returns true.
+func MustErrAsf(t Fatal, err error, target any, format string, args ...any) {
+	if msg, ok := checkErrAs(err, target); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that [errors.As] returns true.
+func (c Checker) ErrAs(err error, target any) bool {
+	if msg, ok := checkErrAs(err, target); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.As] returns true.
+func (c Checker) ErrAsf(err error, target any, format string, args ...any) bool {
+	if msg, ok := checkErrAs(err, target); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.As] returns true.
+func (c MustChecker) ErrAs(err error, target any) {
+	if msg, ok := checkErrAs(err, target); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that [errors.As] returns true.
+func (c MustChecker) ErrAsf(err error, target any, format string, args ...any) {
+	if msg, ok := checkErrAs(err, target); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that err has an error of type E in its tree, returning the first one found, as in [errors.As].
+func ErrorAs[E error](t Error, err error) (target E, ok bool) {
+	target, msg, ok := checkErrorAs[E](err)
+	if !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+	}
+
+	return
+}
+
+// Check that err has an error of type E in its tree, returning the first one found, as in [errors.As].
+func ErrorAsf[E error](t Error, err error, format string, args ...any) (target E, ok bool) {
+	target, msg, ok := checkErrorAs[E](err)
+	if !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+
+	return
+}
+
+// Check that err has an error of type E in its tree, returning the first one found, as in [errors.As].
+func MustErrorAs[E error](t Fatal, err error) (target E) {
+	target, msg, ok := checkErrorAs[E](err)
+	if !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+
+	return
+}
+
+// Check that err has an error of type E in its tree, returning the first one found, as in [errors.As].
+func MustErrorAsf[E error](t Fatal, err error, format string, args ...any) (target E) {
+	target, msg, ok := checkErrorAs[E](err)
+	if !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+
+	return
+}
+
+// Check that err is not nil and that its message contains substr.
+func ErrorContains(t Error, err error, substr string) bool {
+	if msg, ok := checkErrorContains(err, substr); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that err is not nil and that its message contains substr.
+func ErrorContainsf(t Error, err error, substr string, format string, args ...any) bool {
+	if msg, ok := checkErrorContains(err, substr); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that err is not nil and that its message contains substr.
+func MustErrorContains(t Fatal, err error, substr string) {
+	if msg, ok := checkErrorContains(err, substr); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that err is not nil and that its message contains substr.
+func MustErrorContainsf(t Fatal, err error, substr string, format string, args ...any) {
+	if msg, ok := checkErrorContains(err, substr); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that err is not nil and that its message contains substr.
+func (c Checker) ErrorContains(err error, substr string) bool {
+	if msg, ok := checkErrorContains(err, substr); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that err is not nil and that its message contains substr.
+func (c Checker) ErrorContainsf(err error, substr string, format string, args ...any) bool {
+	if msg, ok := checkErrorContains(err, substr); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that err is not nil and that its message contains substr.
+func (c MustChecker) ErrorContains(err error, substr string) {
+	if msg, ok := checkErrorContains(err, substr); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that err is not nil and that its message contains substr.
+func (c MustChecker) ErrorContainsf(err error, substr string, format string, args ...any) {
+	if msg, ok := checkErrorContains(err, substr); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that err is not nil and that its message matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func ErrorMatches(t Error, err error, re any) bool {
+	if msg, ok := checkErrorMatches(err, re); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that err is not nil and that its message matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func ErrorMatchesf(t Error, err error, re any, format string, args ...any) bool {
+	if msg, ok := checkErrorMatches(err, re); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that err is not nil and that its message matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func MustErrorMatches(t Fatal, err error, re any) {
+	if msg, ok := checkErrorMatches(err, re); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that err is not nil and that its message matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func MustErrorMatchesf(t Fatal, err error, re any, format string, args ...any) {
+	if msg, ok := checkErrorMatches(err, re); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that err is not nil and that its message matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c Checker) ErrorMatches(err error, re any) bool {
+	if msg, ok := checkErrorMatches(err, re); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that err is not nil and that its message matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c Checker) ErrorMatchesf(err error, re any, format string, args ...any) bool {
+	if msg, ok := checkErrorMatches(err, re); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that err is not nil and that its message matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c MustChecker) ErrorMatches(err error, re any) {
+	if msg, ok := checkErrorMatches(err, re); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that err is not nil and that its message matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c MustChecker) ErrorMatchesf(err error, re any, format string, args ...any) {
+	if msg, ok := checkErrorMatches(err, re); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that [errors.Is] returns true for at least one of targets.
+func ErrorIsAny(t Error, err error, targets ...error) bool {
+	if msg, ok := checkErrorIsAny(err, targets...); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.Is] returns true for at least one of targets.
+func ErrorIsAnyf(t Error, err error, targets []error, format string, args ...any) bool {
+	if msg, ok := checkErrorIsAny(err, targets...); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.Is] returns true for at least one of targets.
+func MustErrorIsAny(t Fatal, err error, targets ...error) {
+	if msg, ok := checkErrorIsAny(err, targets...); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that [errors.Is] returns true for at least one of targets.
+func MustErrorIsAnyf(t Fatal, err error, targets []error, format string, args ...any) {
+	if msg, ok := checkErrorIsAny(err, targets...); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that [errors.Is] returns true for at least one of targets.
+func (c Checker) ErrorIsAny(err error, targets ...error) bool {
+	if msg, ok := checkErrorIsAny(err, targets...); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.Is] returns true for at least one of targets.
+func (c Checker) ErrorIsAnyf(err error, targets []error, format string, args ...any) bool {
+	if msg, ok := checkErrorIsAny(err, targets...); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that [errors.Is] returns true for at least one of targets.
+func (c MustChecker) ErrorIsAny(err error, targets ...error) {
+	if msg, ok := checkErrorIsAny(err, targets...); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that [errors.Is] returns true for at least one of targets.
+func (c MustChecker) ErrorIsAnyf(err error, targets []error, format string, args ...any) {
+	if msg, ok := checkErrorIsAny(err, targets...); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that map m contains key k.
+func HasKey(t Error, m, k any) bool {
+	if msg, ok := checkHasKey(m, k); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m contains key k.
+func HasKeyf(t Error, m, k any, format string, args ...any) bool {
+	if msg, ok := checkHasKey(m, k); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m contains key k.
+func MustHaveKey(t Fatal, m, k any) {
+	if msg, ok := checkHasKey(m, k); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that map m contains key k.
+func MustHaveKeyf(t Fatal, m, k any, format string, args ...any) {
+	if msg, ok := checkHasKey(m, k); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that map m contains key k.
+func (c Checker) HasKey(m, k any) bool {
+	if msg, ok := checkHasKey(m, k); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m contains key k.
+func (c Checker) HasKeyf(m, k any, format string, args ...any) bool {
+	if msg, ok := checkHasKey(m, k); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m contains key k.
+func (c MustChecker) HasKey(m, k any) {
+	if msg, ok := checkHasKey(m, k); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that map m contains key k.
+func (c MustChecker) HasKeyf(m, k any, format string, args ...any) {
+	if msg, ok := checkHasKey(m, k); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that map m contains key k. This is a type-safe version of [HasKey].
+func HasKeyT[M ~map[K]V, K comparable, V any](t Error, m M, k K) bool {
+	if msg, ok := checkHasKey(m, k); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m contains key k. This is a type-safe version of [HasKey].
+func HasKeyTf[M ~map[K]V, K comparable, V any](t Error, m M, k K, format string, args ...any) bool {
+	if msg, ok := checkHasKey(m, k); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m contains key k. This is a type-safe version of [HasKey].
+func MustHaveKeyT[M ~map[K]V, K comparable, V any](t Fatal, m M, k K) {
+	if msg, ok := checkHasKey(m, k); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that map m contains key k. This is a type-safe version of [HasKey].
+func MustHaveKeyTf[M ~map[K]V, K comparable, V any](t Fatal, m M, k K, format string, args ...any) {
+	if msg, ok := checkHasKey(m, k); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that map m does not contain key k.
+func NotHasKey(t Error, m, k any) bool {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m does not contain key k.
+func NotHasKeyf(t Error, m, k any, format string, args ...any) bool {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m does not contain key k.
+func MustNotHaveKey(t Fatal, m, k any) {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that map m does not contain key k.
+func MustNotHaveKeyf(t Fatal, m, k any, format string, args ...any) {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that map m does not contain key k.
+func (c Checker) NotHasKey(m, k any) bool {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m does not contain key k.
+func (c Checker) NotHasKeyf(m, k any, format string, args ...any) bool {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m does not contain key k.
+func (c MustChecker) NotHasKey(m, k any) {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that map m does not contain key k.
+func (c MustChecker) NotHasKeyf(m, k any, format string, args ...any) {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that map m does not contain key k. This is a type-safe version of [NotHasKey].
+func NotHasKeyT[M ~map[K]V, K comparable, V any](t Error, m M, k K) bool {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m does not contain key k. This is a type-safe version of [NotHasKey].
+func NotHasKeyTf[M ~map[K]V, K comparable, V any](t Error, m M, k K, format string, args ...any) bool {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that map m does not contain key k. This is a type-safe version of [NotHasKey].
+func MustNotHaveKeyT[M ~map[K]V, K comparable, V any](t Fatal, m M, k K) {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that map m does not contain key k. This is a type-safe version of [NotHasKey].
+func MustNotHaveKeyTf[M ~map[K]V, K comparable, V any](t Fatal, m M, k K, format string, args ...any) {
+	if msg, ok := checkNotHasKey(m, k); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that iter contains value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func Contains(t Error, iter, v any) bool {
+	if msg, ok := checkContains(iter, v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that iter contains value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func Containsf(t Error, iter, v any, format string, args ...any) bool {
+	if msg, ok := checkContains(iter, v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that iter contains value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func MustContain(t Fatal, iter, v any) {
+	if msg, ok := checkContains(iter, v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that iter contains value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func MustContainf(t Fatal, iter, v any, format string, args ...any) {
+	if msg, ok := checkContains(iter, v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that iter contains value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func (c Checker) Contains(iter, v any) bool {
+	if msg, ok := checkContains(iter, v); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that iter contains value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func (c Checker) Containsf(iter, v any, format string, args ...any) bool {
+	if msg, ok := checkContains(iter, v); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that iter contains value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func (c MustChecker) Contains(iter, v any) {
+	if msg, ok := checkContains(iter, v); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that iter contains value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func (c MustChecker) Containsf(iter, v any, format string, args ...any) {
+	if msg, ok := checkContains(iter, v); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that slice s contains value v. This is a type-safe version of [Contains].
+func ContainsT[S ~[]E, E any](t Error, s S, v E) bool {
+	if msg, ok := checkContains(s, v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that slice s contains value v. This is a type-safe version of [Contains].
+func ContainsTf[S ~[]E, E any](t Error, s S, v E, format string, args ...any) bool {
+	if msg, ok := checkContains(s, v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that slice s contains value v. This is a type-safe version of [Contains].
+func MustContainT[S ~[]E, E any](t Fatal, s S, v E) {
+	if msg, ok := checkContains(s, v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that slice s contains value v. This is a type-safe version of [Contains].
+func MustContainTf[S ~[]E, E any](t Fatal, s S, v E, format string, args ...any) {
+	if msg, ok := checkContains(s, v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that iter does not contain value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func NotContains(t Error, iter, v any) bool {
+	if msg, ok := checkNotContains(iter, v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that iter does not contain value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func NotContainsf(t Error, iter, v any, format string, args ...any) bool {
+	if msg, ok := checkNotContains(iter, v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that iter does not contain value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func MustNotContain(t Fatal, iter, v any) {
+	if msg, ok := checkNotContains(iter, v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that iter does not contain value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func MustNotContainf(t Fatal, iter, v any, format string, args ...any) {
+	if msg, ok := checkNotContains(iter, v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that iter does not contain value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func (c Checker) NotContains(iter, v any) bool {
+	if msg, ok := checkNotContains(iter, v); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that iter does not contain value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func (c Checker) NotContainsf(iter, v any, format string, args ...any) bool {
+	if msg, ok := checkNotContains(iter, v); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that iter does not contain value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func (c MustChecker) NotContains(iter, v any) {
+	if msg, ok := checkNotContains(iter, v); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that iter does not contain value v. Iter must be one of: map, slice, array, string, iter.Seq, or iter.Seq2 (which is searched by value, like a map).
+func (c MustChecker) NotContainsf(iter, v any, format string, args ...any) {
+	if msg, ok := checkNotContains(iter, v); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that slice s does not contain value v. This is a type-safe version of [NotContains].
+func NotContainsT[S ~[]E, E any](t Error, s S, v E) bool {
+	if msg, ok := checkNotContains(s, v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that slice s does not contain value v. This is a type-safe version of [NotContains].
+func NotContainsTf[S ~[]E, E any](t Error, s S, v E, format string, args ...any) bool {
+	if msg, ok := checkNotContains(s, v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that slice s does not contain value v. This is a type-safe version of [NotContains].
+func MustNotContainT[S ~[]E, E any](t Fatal, s S, v E) {
+	if msg, ok := checkNotContains(s, v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that slice s does not contain value v. This is a type-safe version of [NotContains].
+func MustNotContainTf[S ~[]E, E any](t Fatal, s S, v E, format string, args ...any) {
+	if msg, ok := checkNotContains(s, v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func Matches(t Error, s string, re any) bool {
+	if msg, ok := checkMatches(s, re); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func Matchesf(t Error, s string, re any, format string, args ...any) bool {
+	if msg, ok := checkMatches(s, re); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func MustMatches(t Fatal, s string, re any) {
+	if msg, ok := checkMatches(s, re); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func MustMatchesf(t Fatal, s string, re any, format string, args ...any) {
+	if msg, ok := checkMatches(s, re); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c Checker) Matches(s string, re any) bool {
+	if msg, ok := checkMatches(s, re); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c Checker) Matchesf(s string, re any, format string, args ...any) bool {
+	if msg, ok := checkMatches(s, re); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c MustChecker) Matches(s string, re any) {
+	if msg, ok := checkMatches(s, re); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c MustChecker) Matchesf(s string, re any, format string, args ...any) {
+	if msg, ok := checkMatches(s, re); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s does not match the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func NotMatches(t Error, s string, re any) bool {
+	if msg, ok := checkNotMatches(s, re); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s does not match the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func NotMatchesf(t Error, s string, re any, format string, args ...any) bool {
+	if msg, ok := checkNotMatches(s, re); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s does not match the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func MustNotMatches(t Fatal, s string, re any) {
+	if msg, ok := checkNotMatches(s, re); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s does not match the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func MustNotMatchesf(t Fatal, s string, re any, format string, args ...any) {
+	if msg, ok := checkNotMatches(s, re); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s does not match the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c Checker) NotMatches(s string, re any) bool {
+	if msg, ok := checkNotMatches(s, re); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s does not match the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c Checker) NotMatchesf(s string, re any, format string, args ...any) bool {
+	if msg, ok := checkNotMatches(s, re); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s does not match the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c MustChecker) NotMatches(s string, re any) {
+	if msg, ok := checkNotMatches(s, re); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s does not match the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c MustChecker) NotMatchesf(s string, re any, format string, args ...any) {
+	if msg, ok := checkNotMatches(s, re); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s starts with prefix.
+func HasPrefix(t Error, s, prefix string) bool {
+	if msg, ok := checkHasPrefix(s, prefix); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s starts with prefix.
+func HasPrefixf(t Error, s, prefix string, format string, args ...any) bool {
+	if msg, ok := checkHasPrefix(s, prefix); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s starts with prefix.
+func MustHavePrefix(t Fatal, s, prefix string) {
+	if msg, ok := checkHasPrefix(s, prefix); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s starts with prefix.
+func MustHavePrefixf(t Fatal, s, prefix string, format string, args ...any) {
+	if msg, ok := checkHasPrefix(s, prefix); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s starts with prefix.
+func (c Checker) HasPrefix(s, prefix string) bool {
+	if msg, ok := checkHasPrefix(s, prefix); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s starts with prefix.
+func (c Checker) HasPrefixf(s, prefix string, format string, args ...any) bool {
+	if msg, ok := checkHasPrefix(s, prefix); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s starts with prefix.
+func (c MustChecker) HasPrefix(s, prefix string) {
+	if msg, ok := checkHasPrefix(s, prefix); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s starts with prefix.
+func (c MustChecker) HasPrefixf(s, prefix string, format string, args ...any) {
+	if msg, ok := checkHasPrefix(s, prefix); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s ends with suffix.
+func HasSuffix(t Error, s, suffix string) bool {
+	if msg, ok := checkHasSuffix(s, suffix); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s ends with suffix.
+func HasSuffixf(t Error, s, suffix string, format string, args ...any) bool {
+	if msg, ok := checkHasSuffix(s, suffix); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s ends with suffix.
+func MustHaveSuffix(t Fatal, s, suffix string) {
+	if msg, ok := checkHasSuffix(s, suffix); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s ends with suffix.
+func MustHaveSuffixf(t Fatal, s, suffix string, format string, args ...any) {
+	if msg, ok := checkHasSuffix(s, suffix); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s ends with suffix.
+func (c Checker) HasSuffix(s, suffix string) bool {
+	if msg, ok := checkHasSuffix(s, suffix); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s ends with suffix.
+func (c Checker) HasSuffixf(s, suffix string, format string, args ...any) bool {
+	if msg, ok := checkHasSuffix(s, suffix); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s ends with suffix.
+func (c MustChecker) HasSuffix(s, suffix string) {
+	if msg, ok := checkHasSuffix(s, suffix); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s ends with suffix.
+func (c MustChecker) HasSuffixf(s, suffix string, format string, args ...any) {
+	if msg, ok := checkHasSuffix(s, suffix); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that two strings are equal under simple Unicode case-folding; e is the expected value, g is what was got.
+func EqualFold(t Error, g, e string) bool {
+	if msg, ok := checkEqualFold(g, e); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that two strings are equal under simple Unicode case-folding; e is the expected value, g is what was got.
+func EqualFoldf(t Error, g, e string, format string, args ...any) bool {
+	if msg, ok := checkEqualFold(g, e); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that two strings are equal under simple Unicode case-folding; e is the expected value, g is what was got.
+func MustEqualFold(t Fatal, g, e string) {
+	if msg, ok := checkEqualFold(g, e); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that two strings are equal under simple Unicode case-folding; e is the expected value, g is what was got.
+func MustEqualFoldf(t Fatal, g, e string, format string, args ...any) {
+	if msg, ok := checkEqualFold(g, e); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that two strings are equal under simple Unicode case-folding; e is the expected value, g is what was got.
+func (c Checker) EqualFold(g, e string) bool {
+	if msg, ok := checkEqualFold(g, e); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that two strings are equal under simple Unicode case-folding; e is the expected value, g is what was got.
+func (c Checker) EqualFoldf(g, e string, format string, args ...any) bool {
+	if msg, ok := checkEqualFold(g, e); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that two strings are equal under simple Unicode case-folding; e is the expected value, g is what was got.
+func (c MustChecker) EqualFold(g, e string) {
+	if msg, ok := checkEqualFold(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that two strings are equal under simple Unicode case-folding; e is the expected value, g is what was got.
+func (c MustChecker) EqualFoldf(g, e string, format string, args ...any) {
+	if msg, ok := checkEqualFold(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s contains every one of substrs, in order, without overlapping.
+func ContainsInOrder(t Error, s string, substrs ...string) bool {
+	if msg, ok := checkContainsInOrder(s, substrs...); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s contains every one of substrs, in order, without overlapping.
+func ContainsInOrderf(t Error, s string, substrs []string, format string, args ...any) bool {
+	if msg, ok := checkContainsInOrder(s, substrs...); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s contains every one of substrs, in order, without overlapping.
+func MustContainInOrder(t Fatal, s string, substrs ...string) {
+	if msg, ok := checkContainsInOrder(s, substrs...); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s contains every one of substrs, in order, without overlapping.
+func MustContainInOrderf(t Fatal, s string, substrs []string, format string, args ...any) {
+	if msg, ok := checkContainsInOrder(s, substrs...); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that s contains every one of substrs, in order, without overlapping.
+func (c Checker) ContainsInOrder(s string, substrs ...string) bool {
+	if msg, ok := checkContainsInOrder(s, substrs...); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s contains every one of substrs, in order, without overlapping.
+func (c Checker) ContainsInOrderf(s string, substrs []string, format string, args ...any) bool {
+	if msg, ok := checkContainsInOrder(s, substrs...); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that s contains every one of substrs, in order, without overlapping.
+func (c MustChecker) ContainsInOrder(s string, substrs ...string) {
+	if msg, ok := checkContainsInOrder(s, substrs...); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that s contains every one of substrs, in order, without overlapping.
+func (c MustChecker) ContainsInOrderf(s string, substrs []string, format string, args ...any) {
+	if msg, ok := checkContainsInOrder(s, substrs...); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that collection v has n elements. Collections are slices, arrays, maps, strings (by bytes), channels (by buffered values), and iter.Seq and iter.Seq2.
+func Len(t Error, v any, n int) bool {
+	if msg, ok := checkLen(v, n); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has n elements. Collections are slices, arrays, maps, strings (by bytes), channels (by buffered values), and iter.Seq and iter.Seq2.
+func Lenf(t Error, v any, n int, format string, args ...any) bool {
+	if msg, ok := checkLen(v, n); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has n elements. Collections are slices, arrays, maps, strings (by bytes), channels (by buffered values), and iter.Seq and iter.Seq2.
+func MustHaveLen(t Fatal, v any, n int) {
+	if msg, ok := checkLen(v, n); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that collection v has n elements. Collections are slices, arrays, maps, strings (by bytes), channels (by buffered values), and iter.Seq and iter.Seq2.
+func MustHaveLenf(t Fatal, v any, n int, format string, args ...any) {
+	if msg, ok := checkLen(v, n); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that collection v has n elements. Collections are slices, arrays, maps, strings (by bytes), channels (by buffered values), and iter.Seq and iter.Seq2.
+func (c Checker) Len(v any, n int) bool {
+	if msg, ok := checkLen(v, n); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has n elements. Collections are slices, arrays, maps, strings (by bytes), channels (by buffered values), and iter.Seq and iter.Seq2.
+func (c Checker) Lenf(v any, n int, format string, args ...any) bool {
+	if msg, ok := checkLen(v, n); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has n elements. Collections are slices, arrays, maps, strings (by bytes), channels (by buffered values), and iter.Seq and iter.Seq2.
+func (c MustChecker) Len(v any, n int) {
+	if msg, ok := checkLen(v, n); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that collection v has n elements. Collections are slices, arrays, maps, strings (by bytes), channels (by buffered values), and iter.Seq and iter.Seq2.
+func (c MustChecker) Lenf(v any, n int, format string, args ...any) {
+	if msg, ok := checkLen(v, n); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that collection v has no elements. See [Len] for what counts as a collection.
+func Empty(t Error, v any) bool {
+	if msg, ok := checkEmpty(v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has no elements. See [Len] for what counts as a collection.
+func Emptyf(t Error, v any, format string, args ...any) bool {
+	if msg, ok := checkEmpty(v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has no elements. See [Len] for what counts as a collection.
+func MustBeEmpty(t Fatal, v any) {
+	if msg, ok := checkEmpty(v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that collection v has no elements. See [Len] for what counts as a collection.
+func MustBeEmptyf(t Fatal, v any, format string, args ...any) {
+	if msg, ok := checkEmpty(v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that collection v has no elements. See [Len] for what counts as a collection.
+func (c Checker) Empty(v any) bool {
+	if msg, ok := checkEmpty(v); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has no elements. See [Len] for what counts as a collection.
+func (c Checker) Emptyf(v any, format string, args ...any) bool {
+	if msg, ok := checkEmpty(v); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has no elements. See [Len] for what counts as a collection.
+func (c MustChecker) Empty(v any) {
+	if msg, ok := checkEmpty(v); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that collection v has no elements. See [Len] for what counts as a collection.
+func (c MustChecker) Emptyf(v any, format string, args ...any) {
+	if msg, ok := checkEmpty(v); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that collection v has elements. See [Len] for what counts as a collection.
+func NotEmpty(t Error, v any) bool {
+	if msg, ok := checkNotEmpty(v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has elements. See [Len] for what counts as a collection.
+func NotEmptyf(t Error, v any, format string, args ...any) bool {
+	if msg, ok := checkNotEmpty(v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has elements. See [Len] for what counts as a collection.
+func MustNotBeEmpty(t Fatal, v any) {
+	if msg, ok := checkNotEmpty(v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that collection v has elements. See [Len] for what counts as a collection.
+func MustNotBeEmptyf(t Fatal, v any, format string, args ...any) {
+	if msg, ok := checkNotEmpty(v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that collection v has elements. See [Len] for what counts as a collection.
+func (c Checker) NotEmpty(v any) bool {
+	if msg, ok := checkNotEmpty(v); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has elements. See [Len] for what counts as a collection.
+func (c Checker) NotEmptyf(v any, format string, args ...any) bool {
+	if msg, ok := checkNotEmpty(v); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collection v has elements. See [Len] for what counts as a collection.
+func (c MustChecker) NotEmpty(v any) {
+	if msg, ok := checkNotEmpty(v); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that collection v has elements. See [Len] for what counts as a collection.
+func (c MustChecker) NotEmptyf(v any, format string, args ...any) {
+	if msg, ok := checkNotEmpty(v); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that collections g and e have the same elements, in any order, including duplicates. Maps are compared by their values, strings by their runes, and channels are drained of buffered values. See [Len] for what counts as a collection.
+func ElementsMatch(t Error, g, e any) bool {
+	if msg, ok := checkElementsMatch(g, e); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collections g and e have the same elements, in any order, including duplicates. Maps are compared by their values, strings by their runes, and channels are drained of buffered values. See [Len] for what counts as a collection.
+func ElementsMatchf(t Error, g, e any, format string, args ...any) bool {
+	if msg, ok := checkElementsMatch(g, e); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collections g and e have the same elements, in any order, including duplicates. Maps are compared by their values, strings by their runes, and channels are drained of buffered values. See [Len] for what counts as a collection.
+func MustElementsMatch(t Fatal, g, e any) {
+	if msg, ok := checkElementsMatch(g, e); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that collections g and e have the same elements, in any order, including duplicates. Maps are compared by their values, strings by their runes, and channels are drained of buffered values. See [Len] for what counts as a collection.
+func MustElementsMatchf(t Fatal, g, e any, format string, args ...any) {
+	if msg, ok := checkElementsMatch(g, e); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that collections g and e have the same elements, in any order, including duplicates. Maps are compared by their values, strings by their runes, and channels are drained of buffered values. See [Len] for what counts as a collection.
+func (c Checker) ElementsMatch(g, e any) bool {
+	if msg, ok := checkElementsMatch(g, e); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collections g and e have the same elements, in any order, including duplicates. Maps are compared by their values, strings by their runes, and channels are drained of buffered values. See [Len] for what counts as a collection.
+func (c Checker) ElementsMatchf(g, e any, format string, args ...any) bool {
+	if msg, ok := checkElementsMatch(g, e); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that collections g and e have the same elements, in any order, including duplicates. Maps are compared by their values, strings by their runes, and channels are drained of buffered values. See [Len] for what counts as a collection.
+func (c MustChecker) ElementsMatch(g, e any) {
+	if msg, ok := checkElementsMatch(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that collections g and e have the same elements, in any order, including duplicates. Maps are compared by their values, strings by their runes, and channels are drained of buffered values. See [Len] for what counts as a collection.
+func (c MustChecker) ElementsMatchf(g, e any, format string, args ...any) {
+	if msg, ok := checkElementsMatch(g, e); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that every element of collection sub is in collection super. Elements are gathered as in [ElementsMatch].
+func SubsetOf(t Error, sub, super any) bool {
+	if msg, ok := checkSubsetOf(sub, super); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that every element of collection sub is in collection super. Elements are gathered as in [ElementsMatch].
+func SubsetOff(t Error, sub, super any, format string, args ...any) bool {
+	if msg, ok := checkSubsetOf(sub, super); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that every element of collection sub is in collection super. Elements are gathered as in [ElementsMatch].
+func MustBeSubsetOf(t Fatal, sub, super any) {
+	if msg, ok := checkSubsetOf(sub, super); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that every element of collection sub is in collection super. Elements are gathered as in [ElementsMatch].
+func MustBeSubsetOff(t Fatal, sub, super any, format string, args ...any) {
+	if msg, ok := checkSubsetOf(sub, super); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that every element of collection sub is in collection super. Elements are gathered as in [ElementsMatch].
+func (c Checker) SubsetOf(sub, super any) bool {
+	if msg, ok := checkSubsetOf(sub, super); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that every element of collection sub is in collection super. Elements are gathered as in [ElementsMatch].
+func (c Checker) SubsetOff(sub, super any, format string, args ...any) bool {
+	if msg, ok := checkSubsetOf(sub, super); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that every element of collection sub is in collection super. Elements are gathered as in [ElementsMatch].
+func (c MustChecker) SubsetOf(sub, super any) {
+	if msg, ok := checkSubsetOf(sub, super); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that every element of collection sub is in collection super. Elements are gathered as in [ElementsMatch].
+func (c MustChecker) SubsetOff(sub, super any, format string, args ...any) {
+	if msg, ok := checkSubsetOf(sub, super); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that no two elements of collection v are equal. Elements are gathered as in [ElementsMatch].
+func NoDuplicates(t Error, v any) bool {
+	if msg, ok := checkNoDuplicates(v); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that no two elements of collection v are equal. Elements are gathered as in [ElementsMatch].
+func NoDuplicatesf(t Error, v any, format string, args ...any) bool {
+	if msg, ok := checkNoDuplicates(v); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that no two elements of collection v are equal. Elements are gathered as in [ElementsMatch].
+func MustHaveNoDuplicates(t Fatal, v any) {
+	if msg, ok := checkNoDuplicates(v); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that no two elements of collection v are equal. Elements are gathered as in [ElementsMatch].
+func MustHaveNoDuplicatesf(t Fatal, v any, format string, args ...any) {
+	if msg, ok := checkNoDuplicates(v); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that no two elements of collection v are equal. Elements are gathered as in [ElementsMatch].
+func (c Checker) NoDuplicates(v any) bool {
+	if msg, ok := checkNoDuplicates(v); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that no two elements of collection v are equal. Elements are gathered as in [ElementsMatch].
+func (c Checker) NoDuplicatesf(v any, format string, args ...any) bool {
+	if msg, ok := checkNoDuplicates(v); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that no two elements of collection v are equal. Elements are gathered as in [ElementsMatch].
+func (c MustChecker) NoDuplicates(v any) {
+	if msg, ok := checkNoDuplicates(v); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that no two elements of collection v are equal. Elements are gathered as in [ElementsMatch].
+func (c MustChecker) NoDuplicatesf(v any, format string, args ...any) {
+	if msg, ok := checkNoDuplicates(v); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function panics.
+func Panics(t Error, fn func()) bool {
+	if msg, ok := checkPanics(fn); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics.
+func Panicsf(t Error, fn func(), format string, args ...any) bool {
+	if msg, ok := checkPanics(fn); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics.
+func MustPanic(t Fatal, fn func()) {
+	if msg, ok := checkPanics(fn); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function panics.
+func MustPanicf(t Fatal, fn func(), format string, args ...any) {
+	if msg, ok := checkPanics(fn); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function panics.
+func (c Checker) Panics(fn func()) bool {
+	if msg, ok := checkPanics(fn); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics.
+func (c Checker) Panicsf(fn func(), format string, args ...any) bool {
+	if msg, ok := checkPanics(fn); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics.
+func (c MustChecker) Panics(fn func()) {
+	if msg, ok := checkPanics(fn); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function panics.
+func (c MustChecker) Panicsf(fn func(), format string, args ...any) {
+	if msg, ok := checkPanics(fn); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function does not panic.
+func NotPanics(t Error, fn func()) bool {
+	if msg, ok := checkNotPanics(fn); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function does not panic.
+func NotPanicsf(t Error, fn func(), format string, args ...any) bool {
+	if msg, ok := checkNotPanics(fn); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function does not panic.
+func MustNotPanic(t Fatal, fn func()) {
+	if msg, ok := checkNotPanics(fn); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function does not panic.
+func MustNotPanicf(t Fatal, fn func(), format string, args ...any) {
+	if msg, ok := checkNotPanics(fn); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function does not panic.
+func (c Checker) NotPanics(fn func()) bool {
+	if msg, ok := checkNotPanics(fn); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function does not panic.
+func (c Checker) NotPanicsf(fn func(), format string, args ...any) bool {
+	if msg, ok := checkNotPanics(fn); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function does not panic.
+func (c MustChecker) NotPanics(fn func()) {
+	if msg, ok := checkNotPanics(fn); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function does not panic.
+func (c MustChecker) NotPanicsf(fn func(), format string, args ...any) {
+	if msg, ok := checkNotPanics(fn); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function panics with the given value.
+func PanicsWith(t Error, recovers any, fn func()) bool {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with the given value.
+func PanicsWithf(t Error, recovers any, fn func(), format string, args ...any) bool {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with the given value.
+func MustPanicWith(t Fatal, recovers any, fn func()) {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function panics with the given value.
+func MustPanicWithf(t Fatal, recovers any, fn func(), format string, args ...any) {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function panics with the given value.
+func (c Checker) PanicsWith(recovers any, fn func()) bool {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with the given value.
+func (c Checker) PanicsWithf(recovers any, fn func(), format string, args ...any) bool {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with the given value.
+func (c MustChecker) PanicsWith(recovers any, fn func()) {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function panics with the given value.
+func (c MustChecker) PanicsWithf(recovers any, fn func(), format string, args ...any) {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function panics with the given value. This is a type-safe version of [PanicsWith].
+func PanicsWithT[T any](t Error, recovers T, fn func()) bool {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with the given value. This is a type-safe version of [PanicsWith].
+func PanicsWithTf[T any](t Error, recovers T, fn func(), format string, args ...any) bool {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with the given value. This is a type-safe version of [PanicsWith].
+func MustPanicWithT[T any](t Fatal, recovers T, fn func()) {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function panics with the given value. This is a type-safe version of [PanicsWith].
+func MustPanicWithTf[T any](t Fatal, recovers T, fn func(), format string, args ...any) {
+	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function panics with an error for which [errors.Is] returns true for target.
+func PanicsWithError(t Error, target error, fn func()) bool {
+	if msg, ok := checkPanicsWithError(target, fn); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with an error for which [errors.Is] returns true for target.
+func PanicsWithErrorf(t Error, target error, fn func(), format string, args ...any) bool {
+	if msg, ok := checkPanicsWithError(target, fn); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with an error for which [errors.Is] returns true for target.
+func MustPanicWithError(t Fatal, target error, fn func()) {
+	if msg, ok := checkPanicsWithError(target, fn); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function panics with an error for which [errors.Is] returns true for target.
+func MustPanicWithErrorf(t Fatal, target error, fn func(), format string, args ...any) {
+	if msg, ok := checkPanicsWithError(target, fn); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function panics with an error for which [errors.Is] returns true for target.
+func (c Checker) PanicsWithError(target error, fn func()) bool {
+	if msg, ok := checkPanicsWithError(target, fn); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with an error for which [errors.Is] returns true for target.
+func (c Checker) PanicsWithErrorf(target error, fn func(), format string, args ...any) bool {
+	if msg, ok := checkPanicsWithError(target, fn); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with an error for which [errors.Is] returns true for target.
+func (c MustChecker) PanicsWithError(target error, fn func()) {
+	if msg, ok := checkPanicsWithError(target, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function panics with an error for which [errors.Is] returns true for target.
+func (c MustChecker) PanicsWithErrorf(target error, fn func(), format string, args ...any) {
+	if msg, ok := checkPanicsWithError(target, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function panics with a value whose [fmt.Sprint] matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func PanicsMatching(t Error, re any, fn func()) bool {
+	if msg, ok := checkPanicsMatching(re, fn); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with a value whose [fmt.Sprint] matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func PanicsMatchingf(t Error, re any, fn func(), format string, args ...any) bool {
+	if msg, ok := checkPanicsMatching(re, fn); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with a value whose [fmt.Sprint] matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func MustPanicMatching(t Fatal, re any, fn func()) {
+	if msg, ok := checkPanicsMatching(re, fn); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function panics with a value whose [fmt.Sprint] matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func MustPanicMatchingf(t Fatal, re any, fn func(), format string, args ...any) {
+	if msg, ok := checkPanicsMatching(re, fn); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that the given function panics with a value whose [fmt.Sprint] matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c Checker) PanicsMatching(re any, fn func()) bool {
+	if msg, ok := checkPanicsMatching(re, fn); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with a value whose [fmt.Sprint] matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c Checker) PanicsMatchingf(re any, fn func(), format string, args ...any) bool {
+	if msg, ok := checkPanicsMatching(re, fn); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that the given function panics with a value whose [fmt.Sprint] matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c MustChecker) PanicsMatching(re any, fn func()) {
+	if msg, ok := checkPanicsMatching(re, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that the given function panics with a value whose [fmt.Sprint] matches the regexp re, which is either a [*regexp.Regexp] or a string to compile.
+func (c MustChecker) PanicsMatchingf(re any, fn func(), format string, args ...any) {
+	if msg, ok := checkPanicsMatching(re, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Call fn and check that it panics, returning the recovered value and the stack where the panic happened.
+func Recover(t Error, fn func()) (value any, stack callstack.Stack) {
+	value, stack, msg, ok := checkRecover(fn)
+	if !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+	}
+
+	return
+}
+
+// Call fn and check that it panics, returning the recovered value and the stack where the panic happened.
+func Recoverf(t Error, fn func(), format string, args ...any) (value any, stack callstack.Stack) {
+	value, stack, msg, ok := checkRecover(fn)
+	if !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+
+	return
+}
+
+// Call fn and check that it panics, returning the recovered value and the stack where the panic happened.
+func MustRecover(t Fatal, fn func()) (value any, stack callstack.Stack) {
+	value, stack, msg, ok := checkRecover(fn)
+	if !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+
+	return
+}
+
+// Call fn and check that it panics, returning the recovered value and the stack where the panic happened.
+func MustRecoverf(t Fatal, fn func(), format string, args ...any) (value any, stack callstack.Stack) {
+	value, stack, msg, ok := checkRecover(fn)
+	if !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+
+	return
+}
+
+// Call fn and check that it panics, returning the recovered value and the stack where the panic happened.
+func (c Checker) Recover(fn func()) (value any, stack callstack.Stack) {
+	value, stack, msg, ok := checkRecover(fn)
+	if !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+	}
+
+	return
+}
+
+// Call fn and check that it panics, returning the recovered value and the stack where the panic happened.
+func (c Checker) Recoverf(fn func(), format string, args ...any) (value any, stack callstack.Stack) {
+	value, stack, msg, ok := checkRecover(fn)
+	if !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+
+	return
+}
+
+// Call fn and check that it panics, returning the recovered value and the stack where the panic happened.
+func (c MustChecker) Recover(fn func()) (value any, stack callstack.Stack) {
+	value, stack, msg, ok := checkRecover(fn)
+	if !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+
+	return
+}
+
+// Call fn and check that it panics, returning the recovered value and the stack where the panic happened.
+func (c MustChecker) Recoverf(fn func(), format string, args ...any) (value any, stack callstack.Stack) {
+	value, stack, msg, ok := checkRecover(fn)
+	if !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+
+	return
+}
+
+// Check that a value is received from ch within timeout, returning it.
+func Receives[T any](t Error, ch <-chan T, timeout time.Duration) (v T, ok bool) {
+	v, msg, ok := checkReceives(ch, timeout)
+	if !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+	}
+
+	return
+}
+
+// Check that a value is received from ch within timeout, returning it.
+func Receivesf[T any](t Error, ch <-chan T, timeout time.Duration, format string, args ...any) (v T, ok bool) {
+	v, msg, ok := checkReceives(ch, timeout)
+	if !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+
+	return
+}
+
+// Check that a value is received from ch within timeout, returning it.
+func MustReceive[T any](t Fatal, ch <-chan T, timeout time.Duration) (v T) {
+	v, msg, ok := checkReceives(ch, timeout)
+	if !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+
+	return
+}
+
+// Check that a value is received from ch within timeout, returning it.
+func MustReceivef[T any](t Fatal, ch <-chan T, timeout time.Duration, format string, args ...any) (v T) {
+	v, msg, ok := checkReceives(ch, timeout)
+	if !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+
+	return
+}
+
+// Check that a value is received from ch within timeout, and that it's equal to e.
+func ReceivesEqual[T any](t Error, ch <-chan T, e T, timeout time.Duration) bool {
+	if msg, ok := checkReceivesEqual(ch, e, timeout); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a value is received from ch within timeout, and that it's equal to e.
+func ReceivesEqualf[T any](t Error, ch <-chan T, e T, timeout time.Duration, format string, args ...any) bool {
+	if msg, ok := checkReceivesEqual(ch, e, timeout); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that a value is received from ch within timeout, and that it's equal to e.
+func MustReceiveEqual[T any](t Fatal, ch <-chan T, e T, timeout time.Duration) {
+	if msg, ok := checkReceivesEqual(ch, e, timeout); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that a value is received from ch within timeout, and that it's equal to e.
+func MustReceiveEqualf[T any](t Fatal, ch <-chan T, e T, timeout time.Duration, format string, args ...any) {
+	if msg, ok := checkReceivesEqual(ch, e, timeout); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that the given function panics.
-func MustPanicf(t Fatal, fn func(), format string, args ...any) {
-	if msg, ok := checkPanics(fn); !ok {
+// Check that nothing is received from ch, and that it isn't closed, for d.
+func NeverReceives[T any](t Error, ch <-chan T, d time.Duration) bool {
+	if msg, ok := checkNeverReceives(ch, d); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that nothing is received from ch, and that it isn't closed, for d.
+func NeverReceivesf[T any](t Error, ch <-chan T, d time.Duration, format string, args ...any) bool {
+	if msg, ok := checkNeverReceives(ch, d); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that nothing is received from ch, and that it isn't closed, for d.
+func MustNeverReceive[T any](t Fatal, ch <-chan T, d time.Duration) {
+	if msg, ok := checkNeverReceives(ch, d); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that nothing is received from ch, and that it isn't closed, for d.
+func MustNeverReceivef[T any](t Fatal, ch <-chan T, d time.Duration, format string, args ...any) {
+	if msg, ok := checkNeverReceives(ch, d); !ok {
 		t.Helper()
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that the given function does not panic.
-func NotPanics(t Error, fn func()) bool {
-	if msg, ok := checkNotPanics(fn); !ok {
+// Check that ch is closed, without waiting. If ch has a value buffered, it is received.
+func Closed[T any](t Error, ch <-chan T) bool {
+	if msg, ok := checkClosed(ch); !ok {
 		t.Helper()
 		t.Error("\n" + msg)
 		return false
@@ -587,9 +3848,9 @@ func NotPanics(t Error, fn func()) bool {
 	return true
 }
 
-// Check that the given function does not panic.
-func NotPanicsf(t Error, fn func(), format string, args ...any) bool {
-	if msg, ok := checkNotPanics(fn); !ok {
+// Check that ch is closed, without waiting. If ch has a value buffered, it is received.
+func Closedf[T any](t Error, ch <-chan T, format string, args ...any) bool {
+	if msg, ok := checkClosed(ch); !ok {
 		t.Helper()
 		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
@@ -598,25 +3859,25 @@ func NotPanicsf(t Error, fn func(), format string, args ...any) bool {
 	return true
 }
 
-// Check that the given function does not panic.
-func MustNotPanic(t Fatal, fn func()) {
-	if msg, ok := checkNotPanics(fn); !ok {
+// Check that ch is closed, without waiting. If ch has a value buffered, it is received.
+func MustBeClosed[T any](t Fatal, ch <-chan T) {
+	if msg, ok := checkClosed(ch); !ok {
 		t.Helper()
 		t.Fatal("\n" + msg)
 	}
 }
 
-// Check that the given function does not panic.
-func MustNotPanicf(t Fatal, fn func(), format string, args ...any) {
-	if msg, ok := checkNotPanics(fn); !ok {
+// Check that ch is closed, without waiting. If ch has a value buffered, it is received.
+func MustBeClosedf[T any](t Fatal, ch <-chan T, format string, args ...any) {
+	if msg, ok := checkClosed(ch); !ok {
 		t.Helper()
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
 
-// Check that the given function panics with the given value.
-func PanicsWith(t Error, recovers any, fn func()) bool {
-	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+// Check that v can be sent on ch within timeout.
+func SendsWithin[T any](t Error, ch chan<- T, v T, timeout time.Duration) bool {
+	if msg, ok := checkSendsWithin(ch, v, timeout); !ok {
 		t.Helper()
 		t.Error("\n" + msg)
 		return false
@@ -625,9 +3886,9 @@ func PanicsWith(t Error, recovers any, fn func()) bool {
 	return true
 }
 
-// Check that the given function panics with the given value.
-func PanicsWithf(t Error, recovers any, fn func(), format string, args ...any) bool {
-	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+// Check that v can be sent on ch within timeout.
+func SendsWithinf[T any](t Error, ch chan<- T, v T, timeout time.Duration, format string, args ...any) bool {
+	if msg, ok := checkSendsWithin(ch, v, timeout); !ok {
 		t.Helper()
 		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
 		return false
@@ -636,17 +3897,17 @@ func PanicsWithf(t Error, recovers any, fn func(), format string, args ...any) b
 	return true
 }
 
-// Check that the given function panics with the given value.
-func MustPanicWith(t Fatal, recovers any, fn func()) {
-	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+// Check that v can be sent on ch within timeout.
+func MustSendWithin[T any](t Fatal, ch chan<- T, v T, timeout time.Duration) {
+	if msg, ok := checkSendsWithin(ch, v, timeout); !ok {
 		t.Helper()
 		t.Fatal("\n" + msg)
 	}
 }
 
-// Check that the given function panics with the given value.
-func MustPanicWithf(t Fatal, recovers any, fn func(), format string, args ...any) {
-	if msg, ok := checkPanicsWith(recovers, fn); !ok {
+// Check that v can be sent on ch within timeout.
+func MustSendWithinf[T any](t Fatal, ch chan<- T, v T, timeout time.Duration, format string, args ...any) {
+	if msg, ok := checkSendsWithin(ch, v, timeout); !ok {
 		t.Helper()
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
@@ -690,6 +3951,44 @@ func MustEventuallyTruef(t Fatal, numTries int, fn func(i int) bool, format stri
 	}
 }
 
+// Poll the given function, a max of numTries times, until it returns true.
+func (c Checker) EventuallyTrue(numTries int, fn func(i int) bool) bool {
+	if msg, ok := checkEventuallyTrue(numTries, fn); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, a max of numTries times, until it returns true.
+func (c Checker) EventuallyTruef(numTries int, fn func(i int) bool, format string, args ...any) bool {
+	if msg, ok := checkEventuallyTrue(numTries, fn); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, a max of numTries times, until it returns true.
+func (c MustChecker) EventuallyTrue(numTries int, fn func(i int) bool) {
+	if msg, ok := checkEventuallyTrue(numTries, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Poll the given function, a max of numTries times, until it returns true.
+func (c MustChecker) EventuallyTruef(numTries int, fn func(i int) bool, format string, args ...any) {
+	if msg, ok := checkEventuallyTrue(numTries, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
 // Poll the given function, a max of numTries times, until it doesn't return an error. This is mainly a helper used to exhaust error pathways.
 func EventuallyNil(t Error, numTries int, fn func(i int) error) bool {
 	if msg, ok := checkEventuallyNil(numTries, fn); !ok {
@@ -727,3 +4026,307 @@ func MustEventuallyNilf(t Fatal, numTries int, fn func(i int) error, format stri
 		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
 	}
 }
+
+// Poll the given function, a max of numTries times, until it doesn't return an error. This is mainly a helper used to exhaust error pathways.
+func (c Checker) EventuallyNil(numTries int, fn func(i int) error) bool {
+	if msg, ok := checkEventuallyNil(numTries, fn); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, a max of numTries times, until it doesn't return an error. This is mainly a helper used to exhaust error pathways.
+func (c Checker) EventuallyNilf(numTries int, fn func(i int) error, format string, args ...any) bool {
+	if msg, ok := checkEventuallyNil(numTries, fn); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, a max of numTries times, until it doesn't return an error. This is mainly a helper used to exhaust error pathways.
+func (c MustChecker) EventuallyNil(numTries int, fn func(i int) error) {
+	if msg, ok := checkEventuallyNil(numTries, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Poll the given function, a max of numTries times, until it doesn't return an error. This is mainly a helper used to exhaust error pathways.
+func (c MustChecker) EventuallyNilf(numTries int, fn func(i int) error, format string, args ...any) {
+	if msg, ok := checkEventuallyNil(numTries, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Poll the given function, as configured by p, until it returns true or ctx is done.
+func EventuallyTrueWithin(t Error, ctx context.Context, p Poll, fn func(i int) bool) bool {
+	if msg, ok := checkEventuallyTrueWithin(ctx, p, fn); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, as configured by p, until it returns true or ctx is done.
+func EventuallyTrueWithinf(t Error, ctx context.Context, p Poll, fn func(i int) bool, format string, args ...any) bool {
+	if msg, ok := checkEventuallyTrueWithin(ctx, p, fn); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, as configured by p, until it returns true or ctx is done.
+func MustEventuallyTrueWithin(t Fatal, ctx context.Context, p Poll, fn func(i int) bool) {
+	if msg, ok := checkEventuallyTrueWithin(ctx, p, fn); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Poll the given function, as configured by p, until it returns true or ctx is done.
+func MustEventuallyTrueWithinf(t Fatal, ctx context.Context, p Poll, fn func(i int) bool, format string, args ...any) {
+	if msg, ok := checkEventuallyTrueWithin(ctx, p, fn); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Poll the given function, as configured by p, until it returns true or ctx is done.
+func (c Checker) EventuallyTrueWithin(ctx context.Context, p Poll, fn func(i int) bool) bool {
+	if msg, ok := checkEventuallyTrueWithin(ctx, p, fn); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, as configured by p, until it returns true or ctx is done.
+func (c Checker) EventuallyTrueWithinf(ctx context.Context, p Poll, fn func(i int) bool, format string, args ...any) bool {
+	if msg, ok := checkEventuallyTrueWithin(ctx, p, fn); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, as configured by p, until it returns true or ctx is done.
+func (c MustChecker) EventuallyTrueWithin(ctx context.Context, p Poll, fn func(i int) bool) {
+	if msg, ok := checkEventuallyTrueWithin(ctx, p, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Poll the given function, as configured by p, until it returns true or ctx is done.
+func (c MustChecker) EventuallyTrueWithinf(ctx context.Context, p Poll, fn func(i int) bool, format string, args ...any) {
+	if msg, ok := checkEventuallyTrueWithin(ctx, p, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Poll the given function, as configured by p, until it doesn't return an error or ctx is done.
+func EventuallyNilWithin(t Error, ctx context.Context, p Poll, fn func(i int) error) bool {
+	if msg, ok := checkEventuallyNilWithin(ctx, p, fn); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, as configured by p, until it doesn't return an error or ctx is done.
+func EventuallyNilWithinf(t Error, ctx context.Context, p Poll, fn func(i int) error, format string, args ...any) bool {
+	if msg, ok := checkEventuallyNilWithin(ctx, p, fn); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, as configured by p, until it doesn't return an error or ctx is done.
+func MustEventuallyNilWithin(t Fatal, ctx context.Context, p Poll, fn func(i int) error) {
+	if msg, ok := checkEventuallyNilWithin(ctx, p, fn); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Poll the given function, as configured by p, until it doesn't return an error or ctx is done.
+func MustEventuallyNilWithinf(t Fatal, ctx context.Context, p Poll, fn func(i int) error, format string, args ...any) {
+	if msg, ok := checkEventuallyNilWithin(ctx, p, fn); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Poll the given function, as configured by p, until it doesn't return an error or ctx is done.
+func (c Checker) EventuallyNilWithin(ctx context.Context, p Poll, fn func(i int) error) bool {
+	if msg, ok := checkEventuallyNilWithin(ctx, p, fn); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, as configured by p, until it doesn't return an error or ctx is done.
+func (c Checker) EventuallyNilWithinf(ctx context.Context, p Poll, fn func(i int) error, format string, args ...any) bool {
+	if msg, ok := checkEventuallyNilWithin(ctx, p, fn); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Poll the given function, as configured by p, until it doesn't return an error or ctx is done.
+func (c MustChecker) EventuallyNilWithin(ctx context.Context, p Poll, fn func(i int) error) {
+	if msg, ok := checkEventuallyNilWithin(ctx, p, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Poll the given function, as configured by p, until it doesn't return an error or ctx is done.
+func (c MustChecker) EventuallyNilWithinf(ctx context.Context, p Poll, fn func(i int) error, format string, args ...any) {
+	if msg, ok := checkEventuallyNilWithin(ctx, p, fn); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that got matches the contents of the golden file at testdata/<TestName>/<name>.golden. Strings and []byte are compared as-is; everything else is compared by its [Dump]. When [Updating], the golden file is written instead.
+func Golden(t NamedError, name string, got any) bool {
+	if msg, ok := checkGolden(goldenPath(t.Name(), name), got, Updating()); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that got matches the contents of the golden file at testdata/<TestName>/<name>.golden. Strings and []byte are compared as-is; everything else is compared by its [Dump]. When [Updating], the golden file is written instead.
+func Goldenf(t NamedError, name string, got any, format string, args ...any) bool {
+	if msg, ok := checkGolden(goldenPath(t.Name(), name), got, Updating()); !ok {
+		t.Helper()
+		t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that got matches the contents of the golden file at testdata/<TestName>/<name>.golden. Strings and []byte are compared as-is; everything else is compared by its [Dump]. When [Updating], the golden file is written instead.
+func MustGolden(t NamedFatal, name string, got any) {
+	if msg, ok := checkGolden(goldenPath(t.Name(), name), got, Updating()); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that got matches the contents of the golden file at testdata/<TestName>/<name>.golden. Strings and []byte are compared as-is; everything else is compared by its [Dump]. When [Updating], the golden file is written instead.
+func MustGoldenf(t NamedFatal, name string, got any, format string, args ...any) {
+	if msg, ok := checkGolden(goldenPath(t.Name(), name), got, Updating()); !ok {
+		t.Helper()
+		t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that got matches the contents of the golden file at testdata/<TestName>/<name>.golden. Strings and []byte are compared as-is; everything else is compared by its [Dump]. When [Updating], the golden file is written instead.
+func (c Checker) Golden(name string, got any) bool {
+	if msg, ok := checkGolden(goldenPath(c.t.Name(), name), got, Updating()); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that got matches the contents of the golden file at testdata/<TestName>/<name>.golden. Strings and []byte are compared as-is; everything else is compared by its [Dump]. When [Updating], the golden file is written instead.
+func (c Checker) Goldenf(name string, got any, format string, args ...any) bool {
+	if msg, ok := checkGolden(goldenPath(c.t.Name(), name), got, Updating()); !ok {
+		c.t.Helper()
+		c.t.Error(fmt.Sprintf(format, args...) + "\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that got matches the contents of the golden file at testdata/<TestName>/<name>.golden. Strings and []byte are compared as-is; everything else is compared by its [Dump]. When [Updating], the golden file is written instead.
+func (c MustChecker) Golden(name string, got any) {
+	if msg, ok := checkGolden(goldenPath(c.t.Name(), name), got, Updating()); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
+
+// Check that got matches the contents of the golden file at testdata/<TestName>/<name>.golden. Strings and []byte are compared as-is; everything else is compared by its [Dump]. When [Updating], the golden file is written instead.
+func (c MustChecker) Goldenf(name string, got any, format string, args ...any) {
+	if msg, ok := checkGolden(goldenPath(c.t.Name(), name), got, Updating()); !ok {
+		c.t.Helper()
+		c.t.Fatal(fmt.Sprintf(format, args...) + "\n" + msg)
+	}
+}
+
+// Check that got matches the inline snapshot expect. Strings are compared as-is; everything else is compared by its [Dump]. When [Updating], the expect literal in the calling source file is rewritten to match got.
+func Snapshot(t Error, got any, expect string) bool {
+	if msg, ok := checkSnapshot(callstack.Caller(1), got, expect); !ok {
+		t.Helper()
+		t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that got matches the inline snapshot expect. Strings are compared as-is; everything else is compared by its [Dump]. When [Updating], the expect literal in the calling source file is rewritten to match got.
+func MustSnapshot(t Fatal, got any, expect string) {
+	if msg, ok := checkSnapshot(callstack.Caller(1), got, expect); !ok {
+		t.Helper()
+		t.Fatal("\n" + msg)
+	}
+}
+
+// Check that got matches the inline snapshot expect. Strings are compared as-is; everything else is compared by its [Dump]. When [Updating], the expect literal in the calling source file is rewritten to match got.
+func (c Checker) Snapshot(got any, expect string) bool {
+	if msg, ok := checkSnapshot(callstack.Caller(1), got, expect); !ok {
+		c.t.Helper()
+		c.t.Error("\n" + msg)
+		return false
+	}
+
+	return true
+}
+
+// Check that got matches the inline snapshot expect. Strings are compared as-is; everything else is compared by its [Dump]. When [Updating], the expect literal in the calling source file is rewritten to match got.
+func (c MustChecker) Snapshot(got any, expect string) {
+	if msg, ok := checkSnapshot(callstack.Caller(1), got, expect); !ok {
+		c.t.Helper()
+		c.t.Fatal("\n" + msg)
+	}
+}
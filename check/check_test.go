@@ -3,9 +3,17 @@ package check
 import (
 	"encoding/json"
 	"io/fs"
+	"maps"
 	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"syscall"
 	"testing"
+
+	"github.com/peter-evans/patience"
+	"github.com/thatguystone/cog/callstack"
 )
 
 func testCheck(msg string, ok bool) func(t *testing.T, expect bool) {
@@ -42,12 +50,32 @@ func TestCheckEqual(t *testing.T) {
 	testCheck(checkEqual(1, 1))(t, true)
 	testCheck(checkEqual(1, 2))(t, false)
 	testCheck(checkEqual(int8(1), int16(1)))(t, false)
+
+	t.Run("Seq", func(t *testing.T) {
+		testCheck(checkEqual(slices.Values([]int{1, 2}), []int{1, 2}))(t, true)
+		testCheck(checkEqual([]int{1, 2}, slices.Values([]int{1, 2})))(t, true)
+		testCheck(checkEqual(slices.Values([]int{1}), slices.Values([]int{1})))(t, true)
+		testCheck(checkEqual(slices.Values([]int{1, 2}), []int{2, 1}))(t, false)
+		testCheck(checkEqual(maps.All(map[int]int{1: 2}), map[int]int{1: 2}))(t, true)
+		testCheck(checkEqual(
+			func(yield func(int, int) bool) { _ = yield(1, 1) && yield(1, 2) },
+			map[int]int{1: 2}))(t, false)
+		testCheck(checkEqual(slices.All([]int{1}), slices.All([]int{1})))(t, true)
+		testCheck(checkEqual(slices.All([]int{1}), slices.All([]int{2})))(t, false)
+		testCheck(checkEqual(naturals, []int{}))(t, false)
+
+		stack := callstack.Get()
+		testCheck(checkEqual(stack.Frames(), slices.Collect(stack.Frames())))(t, true)
+	})
 }
 
 func TestCheckNotEqual(t *testing.T) {
 	testCheck(checkNotEqual(1, 2))(t, true)
 	testCheck(checkNotEqual(1, 1))(t, false)
 	testCheck(checkNotEqual(int8(1), int16(2)))(t, false)
+	testCheck(checkNotEqual(slices.Values([]int{1}), []int{2}))(t, true)
+	testCheck(checkNotEqual(slices.Values([]int{1}), []int{1}))(t, false)
+	testCheck(checkNotEqual(naturals, []int{}))(t, false)
 }
 
 func TestCheckNil(t *testing.T) {
@@ -159,7 +187,33 @@ func TestCheckContains(t *testing.T) {
 		testCheck(checkContains("test", 123))(t, false)
 	})
 
+	t.Run("Seq", func(t *testing.T) {
+		testCheck(checkContains(slices.Values([]int{1, 2}), 2))(t, true)
+		testCheck(checkContains(slices.Values([]int{1, 2}), 3))(t, false)
+		testCheck(checkContains(maps.All(map[string]int{"k": 1}), 1))(t, true)
+		testCheck(checkContains(maps.All(map[string]int{"k": 1}), "k"))(t, false)
+		testCheck(checkContains(naturals, 10))(t, true)
+		testCheck(checkContains(naturals, -1))(t, false)
+		testCheck(checkNotContains(slices.Values([]int{1}), 2))(t, true)
+		testCheck(checkNotContains(slices.Values([]int{1}), 1))(t, false)
+
+		msg, _ := checkContains(slices.Values([]int{1}), 2)
+		Contains(t, msg, "Iter:\n"+dumpIndent+dumpIndent+"[]int{\n")
+
+		msg, _ = checkContains(oneShotSeq(1, 2), 3)
+		Contains(
+			t,
+			msg,
+			"Iter:\n"+
+				dumpIndent+dumpIndent+"[]int{\n"+
+				dumpIndent+dumpIndent+dumpIndent+"int(1),\n"+
+				dumpIndent+dumpIndent+dumpIndent+"int(2),\n"+
+				dumpIndent+dumpIndent+"}",
+		)
+	})
+
 	testCheck(checkContains(123, 1))(t, false)
+	testCheck(checkContains(func() {}, 1))(t, false)
 }
 
 func TestCheckNotContains(t *testing.T) {
@@ -225,3 +279,145 @@ func TestCheckEventuallyNil(t *testing.T) {
 	testCheck(checkEventuallyNil(100, func(i int) error { return nil }))(t, true)
 	testCheck(checkEventuallyNil(100, func(i int) error { return os.ErrClosed }))(t, false)
 }
+
+func TestTypeSafe(t *testing.T) {
+	EqualT(t, int64(1), 1)
+	NotEqualT(t, "a", "b")
+	HasKeyT(t, map[string]int{"k": 1}, "k")
+	NotHasKeyT(t, map[string]int{"k": 1}, "v")
+	ContainsT(t, []int{1, 2, 3}, 2)
+	NotContainsT(t, []int{1, 2, 3}, 4)
+	PanicsWithT(t, "check", func() { panic("check") })
+}
+
+func TestCollapseDiff(t *testing.T) {
+	lines := func(n int, prefix string) (ls []string) {
+		for i := range n {
+			ls = append(ls, prefix+strconv.Itoa(i))
+		}
+
+		return
+	}
+
+	var (
+		common = lines(20, "line")
+		g      = slices.Concat(common, []string{"g"}, common)
+		e      = slices.Concat(common, []string{"e"}, common)
+	)
+
+	var b strings.Builder
+	writeLineDiff(&b, g, e)
+
+	Equal(
+		t,
+		b.String(),
+		dumpIndent+"  ... 17 unchanged lines ...\n"+
+			dumpIndent+"  line17\n"+
+			dumpIndent+"  line18\n"+
+			dumpIndent+"  line19\n"+
+			dumpIndent+"- g\n"+
+			dumpIndent+"+ e\n"+
+			dumpIndent+"  line0\n"+
+			dumpIndent+"  line1\n"+
+			dumpIndent+"  line2\n"+
+			dumpIndent+"  ... 17 unchanged lines ...",
+	)
+
+	b.Reset()
+	writeLineDiff(&b, common, common)
+	Equal(t, b.String(), dumpIndent+"  ... 20 unchanged lines ...")
+
+	b.Reset()
+	writeLineDiff(&b, common[:7], common[:7])
+	Equal(t, strings.Count(b.String(), "\n"), 6)
+
+	short := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	Equal(
+		t,
+		collapseDiff(patience.Diff(short, slices.Concat(short, []string{"i"})), 3)[0].Text,
+		"... 5 unchanged lines ...",
+	)
+	Equal(
+		t,
+		len(collapseDiff(patience.Diff(short[:4], slices.Concat(short[:4], []string{"i"})), 3)),
+		5,
+	)
+}
+
+type testMyStr string
+
+func TestSingleLineEqualMsg(t *testing.T) {
+	Contains(t, equalMsg("\xffa", "\xfea"), "first difference at byte 0")
+	NotContains(t, equalMsg(testMyStr("abc"), "abc"), "first difference")
+
+	Equal(
+		t,
+		singleLineEqualMsg("héllo", "héllp", `"héllo"`, `"héllp"`),
+		"Expected: \"héllo\"\n"+
+			"       == \"héllp\"\n"+
+			"               ^ first difference at byte 5",
+	)
+	Equal(
+		t,
+		singleLineEqualMsg(1, 2, "int(1)", "int(2)"),
+		"Expected: int(1)\n"+
+			"       == int(2)\n"+
+			"              ^",
+	)
+	Equal(
+		t,
+		singleLineEqualMsg(1, 1, "int(1)", "int(1)"),
+		"Expected: int(1)\n"+
+			"       == int(1)",
+	)
+}
+
+func TestEqualMsgLongStrings(t *testing.T) {
+	g := strings.Repeat("a", 10_000)
+	e := g[:8000] + "b" + g[8001:]
+
+	msg := equalMsg(g, e)
+	Contains(t, msg, "first difference at byte 8000")
+	Contains(t, msg, "... 5_904 bytes ... ")
+
+	lines := strings.Split(msg, "\n")
+	Equal(t, len(lines), 3)
+	NotEqual(
+		t,
+		strings.TrimPrefix(lines[0], "Expected: "),
+		strings.TrimPrefix(lines[1], "       == "),
+	)
+}
+
+func TestEqualMsgLongLists(t *testing.T) {
+	g := make([]int, 200)
+	e := slices.Clone(g)
+	e[150] = 1
+
+	msg := equalMsg(g, e)
+	Contains(t, msg, "[150]: int(0) != int(1)")
+	Contains(t, msg, "-     int(0),\n"+dumpIndent+"+     int(1),")
+	Contains(t, dumpListAround(reflect.ValueOf(e), 150), "... 80 elements ...")
+
+	gb := make([]byte, 2000)
+	eb := slices.Clone(gb)
+	eb[1500] = 1
+
+	msg = equalMsg(gb, eb)
+	Contains(t, msg, "+     0x00, 0x00, 0x00, 0x00, 0x01,")
+	Contains(t, dumpListAround(reflect.ValueOf(eb), 1500), "... 1_432 elements ...")
+}
+
+func TestCaretLine(t *testing.T) {
+	Equal(t, caretLine(""), "^")
+	Equal(t, caretLine("a\tb"), " \t ^")
+}
+
+func TestLineCarets(t *testing.T) {
+	diffs := patience.Diff(
+		[]string{"0", "abc", "1", "x", "y", "2", "abc"},
+		[]string{"0", "abd", "1", "x2", "y2", "2", "def"},
+	)
+
+	Equal(t, lineCarets(diffs, 0), map[int]string{2: "  ^"})
+}
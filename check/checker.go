@@ -0,0 +1,30 @@
+package check
+
+// TB is the subset of [testing.TB] that a [Checker] needs.
+type TB interface {
+	Error
+	Fatal
+	Name() string
+}
+
+// Checker wraps a [TB] so that it doesn't need to be passed to every check.
+// Every non-generic check is available as a method.
+type Checker struct {
+	t TB
+}
+
+// New creates a new [Checker] that reports to t.
+func New(t TB) Checker {
+	return Checker{t: t}
+}
+
+// Must gets a view of c where every failed check is fatal.
+func (c Checker) Must() MustChecker {
+	return MustChecker{t: c.t}
+}
+
+// MustChecker is like [Checker], except that failed checks call Fatal instead
+// of Error.
+type MustChecker struct {
+	t TB
+}
@@ -0,0 +1,44 @@
+package check
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testTB struct {
+	errors []string
+	fatals []string
+}
+
+func (tb *testTB) Helper() {}
+
+func (tb *testTB) Name() string {
+	return "TestTB"
+}
+
+func (tb *testTB) Error(args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprint(args...))
+}
+
+func (tb *testTB) Fatal(args ...any) {
+	tb.fatals = append(tb.fatals, fmt.Sprint(args...))
+}
+
+func TestChecker(t *testing.T) {
+	tb := new(testTB)
+	c := New(tb)
+
+	True(t, c.Equal(1, 1))
+	False(t, c.Equal(1, 2))
+	False(t, c.Containsf([]int{1}, 2, "fmt %d", 1))
+	Equal(t, len(tb.errors), 2)
+	Contains(t, tb.errors[1], "fmt 1")
+	Equal(t, len(tb.fatals), 0)
+
+	c.Must().Nil(nil)
+	c.Must().Nil(1)
+	c.Must().NotNilf(nil, "fmt %d", 2)
+	Equal(t, len(tb.errors), 2)
+	Equal(t, len(tb.fatals), 2)
+	Contains(t, tb.fatals[1], "fmt 2")
+}
@@ -0,0 +1,281 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// A collection is a container whose elements are being checked
+type collection struct {
+	show any   // What to show in failure messages
+	n    int   // Number of elements
+	els  []any // Elements, if they were requested
+}
+
+// collect gets information about the collection v. Slices and arrays have
+// their elements, maps their values, strings their runes, channels their
+// buffered values, and seqs the values they yield (for an [iter.Seq2], the
+// second value). If els is false, elements are only gathered when that's the
+// only way to count them; otherwise channels are drained.
+func collect(v any, els bool) (c collection, msg string) {
+	rv := reflect.ValueOf(v)
+	c.show = v
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		c.n = rv.Len()
+		if els {
+			c.els = make([]any, c.n)
+			for i := range c.els {
+				c.els[i] = rv.Index(i).Interface()
+			}
+		}
+
+	case reflect.Map:
+		c.n = rv.Len()
+		if els {
+			for _, kv := range sortMap(rv) {
+				c.els = append(c.els, kv.v.Interface())
+			}
+		}
+
+	case reflect.String:
+		c.n = rv.Len()
+		if els {
+			for _, r := range rv.String() {
+				c.els = append(c.els, r)
+			}
+		}
+
+	case reflect.Chan:
+		if !els {
+			c.n = rv.Len()
+			break
+		}
+
+		if rv.Type().ChanDir()&reflect.RecvDir == 0 {
+			msg = fmt.Sprintf("Cannot receive from send-only %T", v)
+			return
+		}
+
+		for {
+			x, ok := rv.TryRecv()
+			if !ok {
+				break
+			}
+
+			c.els = append(c.els, x.Interface())
+		}
+
+		c.n = len(c.els)
+		c.show = c.els
+
+	case reflect.Func:
+		if seqArity(rv.Type()) == 0 {
+			msg = fmt.Sprintf("Cannot get elements of non-collection %T", v)
+			return
+		}
+
+		if rv.IsNil() {
+			break
+		}
+
+		_, vals, more := collectSeq(rv, maxSeqLen)
+		if more {
+			msg = seqTooLongMsg(v)
+			return
+		}
+
+		c.n = len(vals)
+		c.els = make([]any, len(vals))
+		for i, val := range vals {
+			c.els[i] = val.Interface()
+		}
+
+		// Seqs might not be re-runnable, so show what was collected
+		c.show = c.els
+
+	default:
+		msg = fmt.Sprintf("Cannot get elements of non-collection %T", v)
+	}
+
+	return
+}
+
+func checkLen(v any, n int) (string, bool) {
+	c, msg := collect(v, false)
+	if msg != "" {
+		return msg, false
+	}
+
+	if c.n == n {
+		return "", true
+	}
+
+	return fmt.Sprintf("Expected length %d, got %d:\n", n, c.n) + dump(c.show, 1), false
+}
+
+func checkEmpty(v any) (string, bool) {
+	c, msg := collect(v, false)
+	if msg != "" {
+		return msg, false
+	}
+
+	if c.n == 0 {
+		return "", true
+	}
+
+	return "Expected empty, got:\n" + dump(c.show, 1), false
+}
+
+func checkNotEmpty(v any) (string, bool) {
+	c, msg := collect(v, false)
+	if msg != "" {
+		return msg, false
+	}
+
+	if c.n != 0 {
+		return "", true
+	}
+
+	return "Expected not empty, got:\n" + dump(c.show, 1), false
+}
+
+// multisetDiff finds the elements of a that aren't in b, and those of b that
+// aren't in a, accounting for duplicates.
+func multisetDiff(a, b []any) (onlyA, onlyB []any) {
+	used := make([]bool, len(b))
+
+outer:
+	for _, av := range a {
+		for j, bv := range b {
+			if !used[j] && reflect.DeepEqual(av, bv) {
+				used[j] = true
+				continue outer
+			}
+		}
+
+		onlyA = append(onlyA, av)
+	}
+
+	for j, bv := range b {
+		if !used[j] {
+			onlyB = append(onlyB, bv)
+		}
+	}
+
+	return
+}
+
+// indexEqual finds the index of the first element of els that's deeply equal
+// to v, or -1 if there isn't one.
+func indexEqual(els []any, v any) int {
+	return slices.IndexFunc(els, func(el any) bool {
+		return reflect.DeepEqual(el, v)
+	})
+}
+
+// writeElems writes each element on its own, prefixed line
+func writeElems(b *strings.Builder, color bool, ansi, prefix string, els []any) {
+	for _, el := range els {
+		for line := range strings.SplitSeq(dump(el, 0), "\n") {
+			b.WriteString(dumpIndent)
+			writeColored(b, color, ansi, prefix, line)
+			b.WriteByte('\n')
+		}
+	}
+}
+
+func checkElementsMatch(g, e any) (string, bool) {
+	gc, msg := collect(g, true)
+	if msg != "" {
+		return msg, false
+	}
+
+	ec, msg := collect(e, true)
+	if msg != "" {
+		return msg, false
+	}
+
+	extra, missing := multisetDiff(gc.els, ec.els)
+	if len(extra) == 0 && len(missing) == 0 {
+		return "", true
+	}
+
+	color := colorOutput()
+
+	b := new(strings.Builder)
+	b.WriteString("Expected elements to match, in any order:\n")
+	writeElems(b, color, ansiRed, "- ", extra)
+	writeElems(b, color, ansiGreen, "+ ", missing)
+	b.WriteString("\n")
+	b.WriteString(dumpIndent + "Got:\n")
+	b.WriteString(dump(gc.show, 2) + "\n")
+	b.WriteString(dumpIndent + "Expected:\n")
+	b.WriteString(dump(ec.show, 2))
+
+	return b.String(), false
+}
+
+func checkSubsetOf(sub, super any) (string, bool) {
+	sc, msg := collect(sub, true)
+	if msg != "" {
+		return msg, false
+	}
+
+	pc, msg := collect(super, true)
+	if msg != "" {
+		return msg, false
+	}
+
+	var missing []any
+	for _, el := range sc.els {
+		if indexEqual(pc.els, el) == -1 {
+			missing = append(missing, el)
+		}
+	}
+
+	if len(missing) == 0 {
+		return "", true
+	}
+
+	b := new(strings.Builder)
+	b.WriteString("Expected subset, but superset is missing:\n")
+	writeElems(b, colorOutput(), ansiRed, "- ", missing)
+	b.WriteString("\n")
+	b.WriteString(dumpIndent + "Subset:\n")
+	b.WriteString(dump(sc.show, 2) + "\n")
+	b.WriteString(dumpIndent + "Superset:\n")
+	b.WriteString(dump(pc.show, 2))
+
+	return b.String(), false
+}
+
+func checkNoDuplicates(v any) (string, bool) {
+	c, msg := collect(v, true)
+	if msg != "" {
+		return msg, false
+	}
+
+	var dups []any
+	for i, el := range c.els {
+		if indexEqual(c.els[:i], el) == -1 && indexEqual(c.els[i+1:], el) != -1 {
+			dups = append(dups, el)
+		}
+	}
+
+	if len(dups) == 0 {
+		return "", true
+	}
+
+	b := new(strings.Builder)
+	b.WriteString("Expected no duplicates, found:\n")
+	writeElems(b, colorOutput(), ansiRed, "- ", dups)
+	b.WriteString("\n")
+	b.WriteString(dumpIndent + "Iter:\n")
+	b.WriteString(dump(c.show, 2))
+
+	return b.String(), false
+}
@@ -0,0 +1,137 @@
+package check
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCheckLen(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+
+	testCheck(checkLen([]int{1, 2}, 2))(t, true)
+	testCheck(checkLen([2]int{}, 2))(t, true)
+	testCheck(checkLen(map[int]int{1: 1}, 1))(t, true)
+	testCheck(checkLen("abc", 3))(t, true)
+	testCheck(checkLen(ch, 1))(t, true)
+	testCheck(checkLen(slices.Values([]int{1, 2}), 2))(t, true)
+	testCheck(checkLen(maps.All(map[int]int{1: 1}), 1))(t, true)
+	testCheck(checkLen([]int{1}, 2))(t, false)
+	testCheck(checkLen(1, 1))(t, false)
+	testCheck(checkLen(func() {}, 0))(t, false)
+
+	// Counting doesn't drain channels
+	Equal(t, len(ch), 1)
+
+	msg, _ := checkLen([]int{1}, 2)
+	True(t, strings.HasPrefix(msg, "Expected length 2, got 1:\n"))
+
+	msg, _ = checkLen(oneShotSeq(1, 2, 3), 2)
+	Equal(
+		t,
+		msg,
+		"Expected length 2, got 3:\n"+
+			dumpIndent+"[]any{\n"+
+			dumpIndent+dumpIndent+"any(int(1)),\n"+
+			dumpIndent+dumpIndent+"any(int(2)),\n"+
+			dumpIndent+dumpIndent+"any(int(3)),\n"+
+			dumpIndent+"}",
+	)
+}
+
+// oneShotSeq returns a seq that only yields vs the first time it's run
+func oneShotSeq[T any](vs ...T) iter.Seq[T] {
+	done := false
+	return func(yield func(T) bool) {
+		if done {
+			return
+		}
+
+		done = true
+		for _, v := range vs {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func TestCheckEmpty(t *testing.T) {
+	testCheck(checkEmpty([]int(nil)))(t, true)
+	testCheck(checkEmpty(""))(t, true)
+	testCheck(checkEmpty(make(chan int, 1)))(t, true)
+	testCheck(checkEmpty(slices.Values([]int(nil))))(t, true)
+	testCheck(checkEmpty([]int{1}))(t, false)
+	testCheck(checkEmpty(nil))(t, false)
+
+	testCheck(checkNotEmpty([]int{1}))(t, true)
+	testCheck(checkNotEmpty("a"))(t, true)
+	testCheck(checkNotEmpty(map[int]int{}))(t, false)
+	testCheck(checkNotEmpty(nil))(t, false)
+}
+
+func TestCheckElementsMatch(t *testing.T) {
+	testCheck(checkElementsMatch([]int{1, 2, 2}, []int{2, 1, 2}))(t, true)
+	testCheck(checkElementsMatch([]int{1, 2}, [2]int{2, 1}))(t, true)
+	testCheck(checkElementsMatch(map[string]int{"a": 1}, []int{1}))(t, true)
+	testCheck(checkElementsMatch("abc", "cab"))(t, true)
+	testCheck(checkElementsMatch(slices.Values([]int{1, 2}), []int{2, 1}))(t, true)
+	testCheck(checkElementsMatch([]int{1, 2, 2}, []int{1, 1, 2}))(t, false)
+	testCheck(checkElementsMatch([]int{1}, 1))(t, false)
+	testCheck(checkElementsMatch(1, []int{1}))(t, false)
+
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	testCheck(checkElementsMatch(ch, []int{2, 1}))(t, true)
+	Equal(t, len(ch), 0)
+
+	var send chan<- int = ch
+	testCheck(checkElementsMatch(send, []int{}))(t, false)
+
+	msg, _ := checkElementsMatch([]int{1, 2, 3}, []int{1, 4})
+	True(t, strings.HasPrefix(
+		msg,
+		"Expected elements to match, in any order:\n"+
+			dumpIndent+"- int(2)\n"+
+			dumpIndent+"- int(3)\n"+
+			dumpIndent+"+ int(4)\n"+
+			"\n"+
+			dumpIndent+"Got:\n",
+	))
+}
+
+func TestCheckSubsetOf(t *testing.T) {
+	testCheck(checkSubsetOf([]int{1, 1}, []int{3, 2, 1}))(t, true)
+	testCheck(checkSubsetOf([]int{}, []int{}))(t, true)
+	testCheck(checkSubsetOf([]int{1, 4}, []int{3, 2, 1}))(t, false)
+	testCheck(checkSubsetOf(1, []int{1}))(t, false)
+	testCheck(checkSubsetOf([]int{1}, 1))(t, false)
+
+	msg, _ := checkSubsetOf([]int{1, 4}, []int{1})
+	True(t, strings.HasPrefix(
+		msg,
+		"Expected subset, but superset is missing:\n"+
+			dumpIndent+"- int(4)\n",
+	))
+}
+
+func TestCheckNoDuplicates(t *testing.T) {
+	testCheck(checkNoDuplicates([]int{1, 2, 3}))(t, true)
+	testCheck(checkNoDuplicates(map[int]int{1: 1, 2: 2}))(t, true)
+	testCheck(checkNoDuplicates([]int{1, 2, 1}))(t, false)
+	testCheck(checkNoDuplicates(map[int]int{1: 1, 2: 1}))(t, false)
+	testCheck(checkNoDuplicates(1))(t, false)
+
+	msg, _ := checkNoDuplicates([]int{1, 2, 1, 1, 2, 3})
+	True(t, strings.HasPrefix(
+		msg,
+		"Expected no duplicates, found:\n"+
+			dumpIndent+"- int(1)\n"+
+			dumpIndent+"- int(2)\n"+
+			"\n",
+	))
+}
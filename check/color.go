@@ -0,0 +1,86 @@
+package check
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/thatguystone/cog/osx"
+)
+
+const (
+	ansiReset     = "\x1b[0m"
+	ansiRed       = "\x1b[31m"
+	ansiGreen     = "\x1b[32m"
+	ansiReverse   = "\x1b[7m"
+	ansiNoReverse = "\x1b[27m"
+)
+
+// colorOutput determines if failure messages should be colorized. NO_COLOR
+// disables color, FORCE_COLOR enables it, and otherwise it's enabled only when
+// stdout is a terminal.
+var colorOutput = sync.OnceValue(func() bool {
+	return useColor(os.Getenv, func() bool {
+		is, err := osx.IsTerminal(os.Stdout)
+		return err == nil && is
+	})
+})
+
+func useColor(getenv func(string) string, isTerminal func() bool) bool {
+	if getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	if force := getenv("FORCE_COLOR"); force != "" {
+		on, err := strconv.ParseBool(force)
+		return err != nil || on
+	}
+
+	return isTerminal()
+}
+
+// commonAffixes finds the lengths of the common prefix and suffix of a and b,
+// without splitting runes. The prefix and suffix never overlap.
+func commonAffixes(a, b string) (prefix, suffix int) {
+	for prefix < len(a) && prefix < len(b) {
+		// Compare bytes, not runes: all invalid UTF-8 decodes to RuneError
+		_, n := utf8.DecodeRuneInString(a[prefix:])
+		if prefix+n > len(b) || a[prefix:prefix+n] != b[prefix:prefix+n] {
+			break
+		}
+
+		prefix += n
+	}
+
+	for suffix < len(a)-prefix && suffix < len(b)-prefix {
+		_, n := utf8.DecodeLastRuneInString(a[:len(a)-suffix])
+		ai, bi := len(a)-suffix-n, len(b)-suffix-n
+		if ai < prefix || bi < prefix || a[ai:len(a)-suffix] != b[bi:len(b)-suffix] {
+			break
+		}
+
+		suffix += n
+	}
+
+	return
+}
+
+// highlightChange wraps the part of a that differs from b in reverse video
+func highlightChange(a, b string) string {
+	prefix, suffix := commonAffixes(a, b)
+	if prefix+suffix == len(a) {
+		return a
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(a) + len(ansiReverse) + len(ansiNoReverse))
+	sb.WriteString(a[:prefix])
+	sb.WriteString(ansiReverse)
+	sb.WriteString(a[prefix : len(a)-suffix])
+	sb.WriteString(ansiNoReverse)
+	sb.WriteString(a[len(a)-suffix:])
+
+	return sb.String()
+}
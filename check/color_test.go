@@ -0,0 +1,71 @@
+package check
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	// Failure messages are checked verbatim, so they can't change with the
+	// environment running the tests
+	colorOutput = func() bool { return false }
+
+	os.Exit(m.Run())
+}
+
+func TestUseColor(t *testing.T) {
+	test := func(env map[string]string, isTerminal bool) bool {
+		return useColor(
+			func(k string) string { return env[k] },
+			func() bool { return isTerminal },
+		)
+	}
+
+	True(t, test(nil, true))
+	False(t, test(nil, false))
+	False(t, test(map[string]string{"NO_COLOR": "1"}, true))
+	False(t, test(map[string]string{"NO_COLOR": "1", "FORCE_COLOR": "1"}, true))
+	True(t, test(map[string]string{"FORCE_COLOR": "1"}, false))
+	True(t, test(map[string]string{"FORCE_COLOR": "3"}, false))
+	False(t, test(map[string]string{"FORCE_COLOR": "0"}, true))
+	False(t, test(map[string]string{"FORCE_COLOR": "false"}, true))
+}
+
+func TestCommonAffixes(t *testing.T) {
+	test := func(a, b string) [2]int {
+		prefix, suffix := commonAffixes(a, b)
+		return [2]int{prefix, suffix}
+	}
+
+	Equal(t, test("", ""), [2]int{0, 0})
+	Equal(t, test("abc", "abc"), [2]int{3, 0})
+	Equal(t, test("abc", "axc"), [2]int{1, 1})
+	Equal(t, test("aa", "aaa"), [2]int{2, 0})
+	Equal(t, test("aba", "aa"), [2]int{1, 1})
+	Equal(t, test("héllo", "hëllo"), [2]int{1, 3})
+	Equal(t, test("\xffa", "\xfea"), [2]int{0, 1})
+	Equal(t, test("a\xff", "a\xfe"), [2]int{1, 0})
+	Equal(t, test("é", "\xc3"), [2]int{0, 0})
+}
+
+func TestHighlightChange(t *testing.T) {
+	Equal(t, highlightChange("abc", "abc"), "abc")
+	Equal(t, highlightChange("ab", "abc"), "ab")
+	Equal(t, highlightChange("abc", "axc"), "a"+ansiReverse+"b"+ansiNoReverse+"c")
+}
+
+func TestWriteLineDiffColor(t *testing.T) {
+	var b strings.Builder
+	writeLineDiffColor(&b, []string{"a", "bcd", "e"}, []string{"a", "bxd", "f", "e"}, true)
+
+	Equal(
+		t,
+		b.String(),
+		dumpIndent+"  a\n"+
+			dumpIndent+ansiRed+"- b"+ansiReverse+"c"+ansiNoReverse+"d"+ansiReset+"\n"+
+			dumpIndent+ansiGreen+"+ b"+ansiReverse+"x"+ansiNoReverse+"d"+ansiReset+"\n"+
+			dumpIndent+ansiGreen+"+ f"+ansiReset+"\n"+
+			dumpIndent+"  e",
+	)
+}
@@ -0,0 +1,128 @@
+package check
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+)
+
+// operandsMsg describes a failed comparison between a and b
+func operandsMsg(title string, a, b any) string {
+	return title + ":\n" +
+		dumpIndent + "A:\n" +
+		dump(a, 2) +
+		"\n" +
+		dumpIndent + "B:\n" +
+		dump(b, 2)
+}
+
+// The comparisons below use the operators directly, rather than cmp.Compare, so
+// that any comparison involving NaN fails.
+
+func checkLess[T cmp.Ordered](a, b T) (string, bool) {
+	if a < b {
+		return "", true
+	}
+
+	return operandsMsg("Expected a < b", a, b), false
+}
+
+func checkLessOrEqual[T cmp.Ordered](a, b T) (string, bool) {
+	if a <= b {
+		return "", true
+	}
+
+	return operandsMsg("Expected a <= b", a, b), false
+}
+
+func checkGreater[T cmp.Ordered](a, b T) (string, bool) {
+	if a > b {
+		return "", true
+	}
+
+	return operandsMsg("Expected a > b", a, b), false
+}
+
+func checkGreaterOrEqual[T cmp.Ordered](a, b T) (string, bool) {
+	if a >= b {
+		return "", true
+	}
+
+	return operandsMsg("Expected a >= b", a, b), false
+}
+
+func checkPositive[T cmp.Ordered](v T) (string, bool) {
+	var zero T
+	if v > zero {
+		return "", true
+	}
+
+	return "Expected a positive value, got:\n" + dump(v, 1), false
+}
+
+func checkNegative[T cmp.Ordered](v T) (string, bool) {
+	var zero T
+	if v < zero {
+		return "", true
+	}
+
+	return "Expected a negative value, got:\n" + dump(v, 1), false
+}
+
+func checkBetween[T cmp.Ordered](v, lo, hi T) (string, bool) {
+	if v >= lo && v <= hi {
+		return "", true
+	}
+
+	msg := "Expected lo <= v <= hi:\n" +
+		dumpIndent + "V:\n" +
+		dump(v, 2) +
+		"\n" +
+		dumpIndent + "Lo:\n" +
+		dump(lo, 2) +
+		"\n" +
+		dumpIndent + "Hi:\n" +
+		dump(hi, 2)
+	return msg, false
+}
+
+func checkInDelta(g, e, delta float64) (string, bool) {
+	if g == e {
+		return "", true
+	}
+
+	actual := math.Abs(g - e)
+	if actual <= delta {
+		return "", true
+	}
+
+	title := fmt.Sprintf(
+		"Expected values to be within %v of each other, but delta is %v",
+		delta,
+		actual,
+	)
+	return operandsMsg(title, g, e), false
+}
+
+func checkInEpsilon(g, e, epsilon float64) (string, bool) {
+	if g == e {
+		return "", true
+	}
+
+	if e == 0 {
+		title := "Expected value to be within relative error of 0, which is undefined"
+		return operandsMsg(title, g, e), false
+	}
+
+	actual := math.Abs(g-e) / math.Abs(e)
+	if actual <= epsilon {
+		return "", true
+	}
+
+	title := fmt.Sprintf(
+		"Expected relative error to be within %v, but it is %v",
+		epsilon,
+		actual,
+	)
+	return operandsMsg(title, g, e), false
+}
@@ -0,0 +1,79 @@
+package check
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCheckOrdered(t *testing.T) {
+	testCheck(checkLess(1, 2))(t, true)
+	testCheck(checkLess(2, 2))(t, false)
+	testCheck(checkLess("a", "b"))(t, true)
+	testCheck(checkLessOrEqual(2, 2))(t, true)
+	testCheck(checkLessOrEqual(3, 2))(t, false)
+	testCheck(checkGreater(2, 1))(t, true)
+	testCheck(checkGreater(2, 2))(t, false)
+	testCheck(checkGreaterOrEqual(2, 2))(t, true)
+	testCheck(checkGreaterOrEqual(1, 2))(t, false)
+	testCheck(checkLess(math.NaN(), 1))(t, false)
+	testCheck(checkLess(1, math.NaN()))(t, false)
+	testCheck(checkLessOrEqual(math.NaN(), math.NaN()))(t, false)
+	testCheck(checkGreater(math.NaN(), 1))(t, false)
+	testCheck(checkGreater(1, math.NaN()))(t, false)
+	testCheck(checkGreaterOrEqual(math.NaN(), math.NaN()))(t, false)
+
+	msg, _ := checkLess(2, 1)
+	Equal(
+		t,
+		msg,
+		"Expected a < b:\n"+
+			dumpIndent+"A:\n"+
+			dumpIndent+dumpIndent+"int(2)\n"+
+			dumpIndent+"B:\n"+
+			dumpIndent+dumpIndent+"int(1)",
+	)
+}
+
+func TestCheckSign(t *testing.T) {
+	testCheck(checkPositive(1))(t, true)
+	testCheck(checkPositive(0))(t, false)
+	testCheck(checkPositive(-1.5))(t, false)
+	testCheck(checkNegative(-1))(t, true)
+	testCheck(checkNegative(0))(t, false)
+	testCheck(checkNegative(uint(1)))(t, false)
+	testCheck(checkPositive(math.NaN()))(t, false)
+	testCheck(checkNegative(math.NaN()))(t, false)
+}
+
+func TestCheckBetween(t *testing.T) {
+	testCheck(checkBetween(1, 1, 3))(t, true)
+	testCheck(checkBetween(3, 1, 3))(t, true)
+	testCheck(checkBetween(0, 1, 3))(t, false)
+	testCheck(checkBetween(4, 1, 3))(t, false)
+	testCheck(checkBetween("b", "a", "c"))(t, true)
+	testCheck(checkBetween(math.NaN(), 0, 1))(t, false)
+	testCheck(checkBetween(0.5, math.NaN(), 1))(t, false)
+	testCheck(checkBetween(0.5, 0, math.NaN()))(t, false)
+}
+
+func TestCheckInDelta(t *testing.T) {
+	testCheck(checkInDelta(1, 1.05, 0.1))(t, true)
+	testCheck(checkInDelta(1, 1.5, 0.1))(t, false)
+	testCheck(checkInDelta(math.Inf(1), math.Inf(1), 0.1))(t, true)
+	testCheck(checkInDelta(math.Inf(1), math.Inf(-1), 0.1))(t, false)
+	testCheck(checkInDelta(math.NaN(), 1, 0.1))(t, false)
+
+	msg, _ := checkInDelta(1, 1.5, 0.1)
+	Contains(t, msg, "within 0.1 of each other, but delta is 0.5:\n")
+}
+
+func TestCheckInEpsilon(t *testing.T) {
+	testCheck(checkInEpsilon(100, 101, 0.01))(t, true)
+	testCheck(checkInEpsilon(100, 110, 0.01))(t, false)
+	testCheck(checkInEpsilon(0, 0, 0.01))(t, true)
+	testCheck(checkInEpsilon(1, 0, 0.01))(t, false)
+	testCheck(checkInEpsilon(math.NaN(), 1, 0.01))(t, false)
+
+	msg, _ := checkInEpsilon(100, 200, 0.01)
+	Contains(t, msg, "within 0.01, but it is 0.5:\n")
+}
@@ -3,12 +3,16 @@ package check
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 	"unsafe"
+
+	"github.com/thatguystone/cog/check/internal/failmsg"
 )
 
 type circularKey struct {
@@ -32,28 +36,141 @@ func makeCircularKey(rv reflect.Value) (key circularKey, ok bool) {
 	}
 }
 
+// A DumpOption customizes how [Dump] formats values.
+type DumpOption func(*dumpConfig)
+
+type dumpConfig struct {
+	maxDepth    int
+	maxElems    int
+	maxStrLen   int
+	hideTypes   bool
+	hexInts     bool
+	annotations bool
+	indent      string
+}
+
+func defaultDumpConfig() dumpConfig {
+	return dumpConfig{
+		annotations: true,
+		indent:      dumpIndent,
+	}
+}
+
+// msgDumpConfig is used for dumps in failure messages, where huge values
+// would drown out everything else
+func msgDumpConfig() dumpConfig {
+	cfg := defaultDumpConfig()
+	cfg.maxElems = 128
+	cfg.maxStrLen = 4 << 10
+	return cfg
+}
+
+// DumpMaxDepth limits how deeply nested slices, arrays, maps, and structs are
+// dumped; anything deeper is elided. If n <= 0, there is no limit.
+func DumpMaxDepth(n int) DumpOption {
+	return func(cfg *dumpConfig) {
+		cfg.maxDepth = n
+	}
+}
+
+// DumpMaxElements limits how many elements of slices, arrays, and maps are
+// dumped; the rest are elided. If n <= 0, there is no limit.
+func DumpMaxElements(n int) DumpOption {
+	return func(cfg *dumpConfig) {
+		cfg.maxElems = n
+	}
+}
+
+// DumpMaxStringLen limits how many bytes of strings are dumped; the rest are
+// elided. If n <= 0, there is no limit.
+func DumpMaxStringLen(n int) DumpOption {
+	return func(cfg *dumpConfig) {
+		cfg.maxStrLen = n
+	}
+}
+
+// DumpHideTypes omits type names from the dump.
+func DumpHideTypes() DumpOption {
+	return func(cfg *dumpConfig) {
+		cfg.hideTypes = true
+	}
+}
+
+// DumpHex formats integers in hex instead of decimal.
+func DumpHex() DumpOption {
+	return func(cfg *dumpConfig) {
+		cfg.hexInts = true
+	}
+}
+
+// DumpNoAnnotations omits the `/* ... */` annotations that show the result of
+// String() and Error() methods.
+func DumpNoAnnotations() DumpOption {
+	return func(cfg *dumpConfig) {
+		cfg.annotations = false
+	}
+}
+
+// DumpIndent sets the string used for each level of indentation.
+func DumpIndent(indent string) DumpOption {
+	return func(cfg *dumpConfig) {
+		cfg.indent = indent
+	}
+}
+
+// Dump formats v as a human-readable, Go-like literal. This is the same format
+// used in check failure messages. Errors that wrap other errors are shown as a
+// tree of everything they wrap.
+func Dump(v any, opts ...DumpOption) string {
+	cfg := defaultDumpConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return newDumper(cfg, 0).dump(reflect.ValueOf(v))
+}
+
+// Fdump writes the [Dump] of v to w.
+func Fdump(w io.Writer, v any, opts ...DumpOption) error {
+	_, err := io.WriteString(w, Dump(v, opts...))
+	return err
+}
+
 type dumper struct {
+	cfg         dumpConfig
 	buf         bytes.Buffer
 	indentDepth int
+	depth       int // Depth of nested containers
+	listStart   int // Index to start the next slice or array at
 	seen        map[circularKey]struct{}
 	ids         map[circularKey]int
 }
 
 func dump(v any, initialIndent int) string {
-	d := dumper{
+	return dumpValue(reflect.ValueOf(v), initialIndent)
+}
+
+func dumpValue(rv reflect.Value, initialIndent int) string {
+	return newDumper(msgDumpConfig(), initialIndent).dump(rv)
+}
+
+func newDumper(cfg dumpConfig, initialIndent int) *dumper {
+	return &dumper{
+		cfg:         cfg,
 		indentDepth: initialIndent,
 		seen:        make(map[circularKey]struct{}),
 		ids:         make(map[circularKey]int),
 	}
+}
 
-	if initialIndent > 0 {
+func (d *dumper) dump(rv reflect.Value) string {
+	if d.indentDepth > 0 {
 		d.writeIndent()
 	}
 
-	if v == nil {
+	if !rv.IsValid() {
 		d.buf.WriteString("nil")
 	} else {
-		rv := reflect.ValueOf(v)
 		d.walkCirculars(rv)
 		d.fmtVal(rv)
 	}
@@ -62,7 +179,7 @@ func dump(v any, initialIndent int) string {
 }
 
 const (
-	dumpIndent   = "    "
+	dumpIndent   = failmsg.Indent
 	maxBase10Len = 26 // len("-9_223_372_036_854_775_808")
 	maxBase16Len = 19 // len("-0x8000000000000000")
 )
@@ -127,13 +244,14 @@ func (d *dumper) fmtVal(rv reflect.Value) {
 	key, ok := makeCircularKey(rv)
 	if ok {
 		if _, ok := d.seen[key]; ok {
-			if rv.Kind() == reflect.Pointer {
+			parens := rv.Kind() == reflect.Pointer && !d.cfg.hideTypes
+			if parens {
 				d.buf.WriteByte('(')
 			}
 
 			d.writeType(rv)
 
-			if rv.Kind() == reflect.Pointer {
+			if parens {
 				d.buf.WriteByte(')')
 			}
 
@@ -149,6 +267,14 @@ func (d *dumper) fmtVal(rv reflect.Value) {
 		defer delete(d.seen, key)
 	}
 
+	if d.fmtCustom(rv) {
+		return
+	}
+
+	if d.fmtErrorTree(rv) {
+		return
+	}
+
 	d.writeAnnotation(rv)
 
 	switch rv.Kind() {
@@ -174,17 +300,84 @@ func (d *dumper) fmtVal(rv reflect.Value) {
 		d.fmtPointer(rv)
 	case reflect.Interface:
 		d.fmtInterface(rv)
-	case reflect.Chan, reflect.Func, reflect.Uintptr, reflect.UnsafePointer:
+	case reflect.Func:
+		if isSeq(rv) {
+			d.fmtSeq(rv)
+		} else {
+			d.fmtOpaquePointer(rv)
+		}
+	case reflect.Chan, reflect.Uintptr, reflect.UnsafePointer:
 		d.fmtOpaquePointer(rv)
 	default:
 		panic(fmt.Errorf("unexpected type: %s", rv.Type()))
 	}
 }
 
+// fmtErrorTree formats errors that wrap other errors as a tree of their causes
+func (d *dumper) fmtErrorTree(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return false
+		}
+	}
+
+	rv, ok := interfaceable(rv)
+	if !ok {
+		return false
+	}
+
+	err, ok := rv.Interface().(error)
+	if !ok {
+		return false
+	}
+
+	tree, ok := func() (tree string, ok bool) {
+		// Let the usual formatting report on errors that panic
+		defer func() {
+			if recover() != nil {
+				ok = false
+			}
+		}()
+
+		if len(unwrapError(err)) == 0 {
+			return
+		}
+
+		tree = formatErrorTree(err, func(err error) string {
+			msg := newDumper(d.cfg, 0).dump(reflect.ValueOf(err.Error()))
+			if d.cfg.hideTypes {
+				return msg
+			}
+
+			return fmt.Sprintf("%T: %s", err, msg)
+		})
+		ok = true
+		return
+	}()
+
+	if !ok {
+		return false
+	}
+
+	for i, line := range strings.Split(tree, "\n") {
+		if i > 0 {
+			d.buf.WriteByte('\n')
+			d.writeIndent()
+		}
+
+		d.buf.WriteString(line)
+	}
+
+	return true
+}
+
 func (d *dumper) fmtBool(rv reflect.Value) {
 	var (
 		typeName = rv.Type().String()
-		hasType  = typeName != rv.Kind().String()
+		hasType  = !d.cfg.hideTypes && typeName != rv.Kind().String()
 	)
 
 	if hasType {
@@ -204,41 +397,51 @@ func (d *dumper) fmtBool(rv reflect.Value) {
 }
 
 func (d *dumper) fmtInt(rv reflect.Value) {
-	d.writeType(rv)
-	d.buf.WriteByte('(')
+	d.openType(rv)
 
-	d.buf.Grow(maxBase10Len)
-	b := d.buf.AvailableBuffer()
-	b = strconv.AppendInt(b, rv.Int(), 10)
-	b = fmtBase10(b)
-	d.buf.Write(b)
+	v := rv.Int()
+	if d.cfg.hexInts {
+		neg := v < 0
+		if neg {
+			v = -v
+		}
 
-	d.buf.WriteByte(')')
+		d.writeHex(uint64(v), neg)
+	} else {
+		d.buf.Grow(maxBase10Len)
+		b := d.buf.AvailableBuffer()
+		b = strconv.AppendInt(b, v, 10)
+		b = fmtBase10(b)
+		d.buf.Write(b)
+	}
+
+	d.closeType()
 }
 
 func (d *dumper) fmtUint(rv reflect.Value) {
-	d.writeType(rv)
-	d.buf.WriteByte('(')
+	d.openType(rv)
 
-	d.buf.Grow(maxBase10Len)
-	b := d.buf.AvailableBuffer()
-	b = strconv.AppendUint(b, rv.Uint(), 10)
-	b = fmtBase10(b)
-	d.buf.Write(b)
+	if d.cfg.hexInts {
+		d.writeHex(rv.Uint(), false)
+	} else {
+		d.buf.Grow(maxBase10Len)
+		b := d.buf.AvailableBuffer()
+		b = strconv.AppendUint(b, rv.Uint(), 10)
+		b = fmtBase10(b)
+		d.buf.Write(b)
+	}
 
-	d.buf.WriteByte(')')
+	d.closeType()
 }
 
 func (d *dumper) fmtFloat(rv reflect.Value) {
-	d.writeType(rv)
-	d.buf.WriteByte('(')
+	d.openType(rv)
 	d.writeFloat(rv.Float(), true)
-	d.buf.WriteByte(')')
+	d.closeType()
 }
 
 func (d *dumper) fmtComplex(rv reflect.Value) {
-	d.writeType(rv)
-	d.buf.WriteByte('(')
+	d.openType(rv)
 
 	v := rv.Complex()
 	d.writeFloat(real(v), false)
@@ -257,13 +460,19 @@ func (d *dumper) fmtComplex(rv reflect.Value) {
 	d.writeFloat(im, false)
 	d.buf.WriteByte('i')
 
-	d.buf.WriteByte(')')
+	d.closeType()
 }
 
 func (d *dumper) fmtString(rv reflect.Value) {
+	d.fmtStringFrom(rv, 0)
+}
+
+// fmtStringFrom formats the string rv, starting at byte start. Anything before
+// start is elided.
+func (d *dumper) fmtStringFrom(rv reflect.Value, start int) {
 	var (
 		typeName = rv.Type().String()
-		hasType  = typeName != rv.Kind().String()
+		hasType  = !d.cfg.hideTypes && typeName != rv.Kind().String()
 	)
 
 	if hasType {
@@ -271,7 +480,19 @@ func (d *dumper) fmtString(rv reflect.Value) {
 		d.buf.WriteByte('(')
 	}
 
-	d.writeGoString(rv.String())
+	if start > 0 {
+		d.writeSkipped(start, "bytes")
+		d.buf.WriteByte(' ')
+	}
+
+	str := rv.String()[start:]
+	n := d.strLimit(str)
+	d.writeGoString(str[:n])
+
+	if n < len(str) {
+		d.buf.WriteByte(' ')
+		d.writeMore(len(str)-n, "bytes")
+	}
 
 	if hasType {
 		d.buf.WriteByte(')')
@@ -279,34 +500,45 @@ func (d *dumper) fmtString(rv reflect.Value) {
 }
 
 func (d *dumper) fmtSlice(rv reflect.Value) {
+	if rv.Kind() == reflect.Slice && rv.IsNil() {
+		d.writeNil(rv)
+		return
+	}
+
 	d.writeType(rv)
 
-	if rv.Kind() == reflect.Slice {
-		if rv.IsNil() {
-			d.buf.WriteString("(nil)")
-			return
-		}
+	if rv.Kind() == reflect.Slice && rv.Len() == 0 {
+		d.buf.WriteString("{}")
+		return
+	}
 
-		if rv.Len() == 0 {
-			d.buf.WriteString("{}")
-			return
-		}
+	if d.elideDepth() {
+		return
 	}
 
 	d.buf.WriteString("{")
 	d.indent()
 
+	start := min(d.listStart, rv.Len())
+	d.listStart = 0
+
+	n := d.elemLimit(rv.Len() - start)
+
 	if rv.Type().Elem() == reflect.TypeOf(byte(0)) {
 		var (
-			n            = rv.Len()
 			nlines       = (n / 8) + 1
-			lineOverhead = (len(dumpIndent) * d.indentDepth) + 1 // indent + nl
+			lineOverhead = (len(d.cfg.indent) * d.indentDepth) + 1 // indent + nl
 		)
 
 		d.buf.Grow((n * len("0x00, ")) + (nlines * lineOverhead))
 
-		for i := range n {
-			if i%8 == 0 {
+		if start > 0 {
+			d.buf.WriteString("\n")
+			d.writeSkippedElems(start)
+		}
+
+		for i := start; i < start+n; i++ {
+			if (i-start)%8 == 0 {
 				d.buf.WriteString("\n")
 				d.writeIndent()
 			} else {
@@ -327,35 +559,46 @@ func (d *dumper) fmtSlice(rv reflect.Value) {
 		d.buf.WriteString("\n")
 	} else {
 		d.buf.WriteString("\n")
-		for i := range rv.Len() {
+		if start > 0 {
+			d.writeSkippedElems(start)
+			d.buf.WriteString("\n")
+		}
+
+		for i := start; i < start+n; i++ {
 			d.writeIndent()
 			d.fmtVal(rv.Index(i))
 			d.buf.WriteString(",\n")
 		}
 	}
 
+	d.writeElided(rv.Len() - start - n)
 	d.dedent()
 	d.writeIndent()
 	d.buf.WriteByte('}')
 }
 
 func (d *dumper) fmtMap(rv reflect.Value) {
-	d.writeType(rv)
-
 	if rv.IsNil() {
-		d.buf.WriteString("(nil)")
+		d.writeNil(rv)
 		return
 	}
 
+	d.writeType(rv)
+
 	if rv.Len() == 0 {
 		d.buf.WriteString("{}")
 		return
 	}
 
+	if d.elideDepth() {
+		return
+	}
+
 	d.buf.WriteString("{\n")
 	d.indent()
 
-	for _, kv := range sortMap(rv) {
+	n := d.elemLimit(rv.Len())
+	for _, kv := range sortMap(rv)[:n] {
 		d.writeIndent()
 		d.fmtVal(kv.k)
 		d.buf.WriteString(": ")
@@ -363,6 +606,7 @@ func (d *dumper) fmtMap(rv reflect.Value) {
 		d.buf.WriteString(",\n")
 	}
 
+	d.writeElided(rv.Len() - n)
 	d.dedent()
 	d.writeIndent()
 	d.buf.WriteByte('}')
@@ -381,6 +625,10 @@ func (d *dumper) fmtStruct(rv reflect.Value) {
 		return
 	}
 
+	if d.elideDepth() {
+		return
+	}
+
 	d.buf.WriteString("{\n")
 	d.indent()
 
@@ -399,14 +647,11 @@ func (d *dumper) fmtStruct(rv reflect.Value) {
 
 func (d *dumper) fmtPointer(rv reflect.Value) {
 	if rv.IsNil() {
-		d.buf.WriteByte('(')
-		d.writeType(rv)
-		d.buf.WriteByte(')')
-		d.buf.WriteString("(nil)")
+		d.writeNil(rv)
 		return
 	}
 
-	typeChange := rv.Type().Name() != ""
+	typeChange := !d.cfg.hideTypes && rv.Type().Name() != ""
 	if typeChange {
 		d.writeType(rv)
 		d.buf.WriteByte('(')
@@ -421,8 +666,7 @@ func (d *dumper) fmtPointer(rv reflect.Value) {
 }
 
 func (d *dumper) fmtInterface(rv reflect.Value) {
-	d.writeType(rv)
-	d.buf.WriteByte('(')
+	d.openType(rv)
 
 	if rv.IsNil() {
 		d.buf.WriteString("nil")
@@ -430,13 +674,80 @@ func (d *dumper) fmtInterface(rv reflect.Value) {
 		d.fmtVal(rv.Elem())
 	}
 
-	d.buf.WriteByte(')')
+	d.closeType()
 }
 
-func (d *dumper) fmtOpaquePointer(rv reflect.Value) {
-	d.buf.WriteByte('(')
+// fmtSeq formats the values yielded by an [iter.Seq] like a slice, and those
+// yielded by an [iter.Seq2] like a map.
+func (d *dumper) fmtSeq(rv reflect.Value) {
 	d.writeType(rv)
-	d.buf.WriteString(")(")
+
+	if d.elideDepth() {
+		return
+	}
+
+	limit := maxSeqLen
+	if d.cfg.maxElems > 0 {
+		limit = d.cfg.maxElems
+	}
+
+	var (
+		keys, vals []reflect.Value
+		more       bool
+	)
+
+	panicked := func() (panicked bool) {
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Fprintf(&d.buf, "(PANIC=%q)", r)
+				panicked = true
+			}
+		}()
+
+		keys, vals, more = collectSeq(rv, limit)
+		return
+	}()
+
+	if panicked {
+		return
+	}
+
+	if len(vals) == 0 && !more {
+		d.buf.WriteString("{}")
+		return
+	}
+
+	d.buf.WriteString("{\n")
+	d.indent()
+
+	for i, v := range vals {
+		d.writeIndent()
+
+		if keys != nil {
+			d.fmtVal(keys[i])
+			d.buf.WriteString(": ")
+		}
+
+		d.fmtVal(v)
+		d.buf.WriteString(",\n")
+	}
+
+	if more {
+		d.writeIndent()
+		d.buf.WriteString("... more elements ...\n")
+	}
+
+	d.dedent()
+	d.writeIndent()
+	d.buf.WriteByte('}')
+}
+
+func (d *dumper) fmtOpaquePointer(rv reflect.Value) {
+	if !d.cfg.hideTypes {
+		d.buf.WriteByte('(')
+		d.writeType(rv)
+		d.buf.WriteString(")(")
+	}
 
 	var ptr uint64
 	if rv.Kind() == reflect.Uintptr {
@@ -448,17 +759,17 @@ func (d *dumper) fmtOpaquePointer(rv reflect.Value) {
 	if ptr == 0 {
 		d.buf.WriteString("nil")
 	} else {
-		d.buf.Grow(maxBase16Len)
-		b := d.buf.AvailableBuffer()
-		b = append(b, "0x"...)
-		b = strconv.AppendUint(b, ptr, 16)
-		d.buf.Write(b)
+		d.writeHex(ptr, false)
 	}
 
-	d.buf.WriteByte(')')
+	d.closeType()
 }
 
 func (d *dumper) writeAnnotation(rv reflect.Value) {
+	if !d.cfg.annotations {
+		return
+	}
+
 	// Only annotate concrete values: pointers and interfaces all resolve into
 	// concrete types, so annotating them results in printing the same thing
 	// multiple times
@@ -532,6 +843,10 @@ func (d *dumper) writeGoString(v string) {
 }
 
 func (d *dumper) writeType(rv reflect.Value) {
+	if d.cfg.hideTypes {
+		return
+	}
+
 	name := rv.Type().String()
 	name = strings.ReplaceAll(name, "interface {}", "any")
 	name = strings.ReplaceAll(name, "interface{}", "any")
@@ -556,18 +871,171 @@ func (d *dumper) writeFloat(v float64, ensureDot bool) {
 	d.buf.Write(b)
 }
 
+// openType writes the start of a `Type(value)` wrapper, if types are shown
+func (d *dumper) openType(rv reflect.Value) {
+	if !d.cfg.hideTypes {
+		d.writeType(rv)
+		d.buf.WriteByte('(')
+	}
+}
+
+func (d *dumper) closeType() {
+	if !d.cfg.hideTypes {
+		d.buf.WriteByte(')')
+	}
+}
+
+func (d *dumper) writeNil(rv reflect.Value) {
+	if d.cfg.hideTypes {
+		d.buf.WriteString("nil")
+		return
+	}
+
+	if rv.Kind() == reflect.Pointer {
+		d.buf.WriteByte('(')
+		d.writeType(rv)
+		d.buf.WriteByte(')')
+	} else {
+		d.writeType(rv)
+	}
+
+	d.buf.WriteString("(nil)")
+}
+
+func (d *dumper) writeHex(v uint64, neg bool) {
+	d.buf.Grow(maxBase16Len)
+	b := d.buf.AvailableBuffer()
+	if neg {
+		b = append(b, '-')
+	}
+	b = append(b, "0x"...)
+	b = strconv.AppendUint(b, v, 16)
+	d.buf.Write(b)
+}
+
+// elideDepth elides the contents of a container if it's nested too deeply
+func (d *dumper) elideDepth() bool {
+	if d.cfg.maxDepth <= 0 || d.depth < d.cfg.maxDepth {
+		return false
+	}
+
+	d.buf.WriteString("{...}")
+	return true
+}
+
+// strStartAround finds where to start dumping str so that as much as possible
+// of what's around byte off is shown, without splitting any runes.
+func (d *dumper) strStartAround(str string, off int) int {
+	if d.cfg.maxStrLen <= 0 || len(str) <= d.cfg.maxStrLen {
+		return 0
+	}
+
+	start := min(off-(d.cfg.maxStrLen/2), len(str)-d.cfg.maxStrLen)
+	if start <= 0 {
+		return 0
+	}
+
+	for start > 0 && !utf8.RuneStart(str[start]) {
+		start--
+	}
+
+	return start
+}
+
+// dumpStringAround dumps the string v for a failure message, centered around
+// byte off if it's too long to dump all of it.
+func dumpStringAround(v reflect.Value, off int) string {
+	d := newDumper(msgDumpConfig(), 0)
+	d.fmtStringFrom(v, d.strStartAround(v.String(), off))
+	return d.buf.String()
+}
+
+// dumpListAround dumps v for a failure message. If v is a slice or array
+// that's too long to dump all of it, what's dumped is centered around index i.
+func dumpListAround(v reflect.Value, i int) string {
+	d := newDumper(msgDumpConfig(), 0)
+	if d.cfg.maxElems > 0 && i >= d.cfg.maxElems {
+		d.listStart = i - (d.cfg.maxElems / 2)
+
+		// Keep bytes in the same columns
+		d.listStart -= d.listStart % 8
+	}
+
+	return d.dump(v)
+}
+
+// elemLimit gets the number of elements of a container that should be dumped
+func (d *dumper) elemLimit(n int) int {
+	if d.cfg.maxElems <= 0 {
+		return n
+	}
+
+	return min(n, d.cfg.maxElems)
+}
+
+// strLimit gets the number of bytes of str that should be dumped, without
+// splitting any runes
+func (d *dumper) strLimit(str string) int {
+	if d.cfg.maxStrLen <= 0 || len(str) <= d.cfg.maxStrLen {
+		return len(str)
+	}
+
+	n := d.cfg.maxStrLen
+	for n > 0 && !utf8.RuneStart(str[n]) {
+		n--
+	}
+
+	return n
+}
+
+func (d *dumper) writeElided(n int) {
+	if n <= 0 {
+		return
+	}
+
+	d.writeIndent()
+	d.writeMore(n, "elements")
+	d.buf.WriteByte('\n')
+}
+
+// writeSkippedElems writes a marker for the first n elements of a list not
+// being shown
+func (d *dumper) writeSkippedElems(n int) {
+	d.writeIndent()
+	d.writeSkipped(n, "elements")
+}
+
+func (d *dumper) writeMore(n int, what string) {
+	d.writeSkipped(n, "more "+what)
+}
+
+// writeSkipped writes a marker for n things that aren't shown
+func (d *dumper) writeSkipped(n int, what string) {
+	d.buf.WriteString("... ")
+
+	d.buf.Grow(maxBase10Len)
+	b := d.buf.AvailableBuffer()
+	b = strconv.AppendInt(b, int64(n), 10)
+	b = fmtBase10(b)
+	d.buf.Write(b)
+
+	d.buf.WriteString(" " + what + " ...")
+}
+
 func (d *dumper) indent() {
 	d.indentDepth++
+	d.depth++
 }
 
 func (d *dumper) dedent() {
 	d.indentDepth--
+	d.depth--
 }
 
 func (d *dumper) writeIndent() {
-	d.buf.Grow(len(dumpIndent) * d.indentDepth)
+	d.buf.Grow(len(d.cfg.indent) * d.indentDepth)
 	for range d.indentDepth {
-		d.buf.WriteString(dumpIndent)
+		d.buf.WriteString(d.cfg.indent)
 	}
 }
 
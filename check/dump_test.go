@@ -1,9 +1,15 @@
 package check
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"iter"
+	"maps"
 	"math"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -116,6 +122,43 @@ func TestDumpMaps(t *testing.T) {
 	Equal(t, testDump(mempty), "map[int]int{}")
 }
 
+func TestDumpSeqs(t *testing.T) {
+	Equal(
+		t,
+		testDump(slices.Values([]int{1, 2})),
+		"iter.Seq[int]{\n"+
+			dumpIndent+"int(1),\n"+
+			dumpIndent+"int(2),\n"+
+			"}",
+	)
+	Equal(
+		t,
+		testDump(maps.All(map[string]int{"a": 1})),
+		"iter.Seq2[string,int]{\n"+
+			dumpIndent+`"a": int(1),`+"\n"+
+			"}",
+	)
+	Equal(t, testDump(slices.Values([]int(nil))), "iter.Seq[int]{}")
+	Equal(t, testDump(iter.Seq[int](nil)), "(iter.Seq[int])(nil)")
+
+	Contains(t, testDump(naturals), dumpIndent+"... more elements ...\n")
+	Contains(
+		t,
+		Dump(naturals, DumpMaxElements(2)),
+		"func(func(int) bool){\n"+
+			dumpIndent+"int(0),\n"+
+			dumpIndent+"int(1),\n"+
+			dumpIndent+"... more elements ...\n"+
+			"}",
+	)
+
+	panics := func(yield func(int) bool) {
+		yield(1)
+		panic("oops")
+	}
+	Equal(t, testDump(panics), `func(func(int) bool)(PANIC="oops")`)
+}
+
 type testStringer string
 
 func (s testStringer) String() string {
@@ -157,6 +200,47 @@ func TestDumpAnnotations(t *testing.T) {
 	})
 }
 
+func TestDumpErrorTrees(t *testing.T) {
+	err := fmt.Errorf("load: %w", errors.Join(io.EOF, io.ErrUnexpectedEOF))
+
+	Equal(
+		t,
+		testDump(err),
+		`*fmt.wrapError: "load: EOF\nunexpected EOF"`+"\n"+
+			`└── *errors.joinError: "EOF\nunexpected EOF"`+"\n"+
+			`    ├── *errors.errorString: "EOF"`+"\n"+
+			`    └── *errors.errorString: "unexpected EOF"`,
+	)
+
+	Equal(
+		t,
+		testDump(struct{ Err error }{fmt.Errorf("a: %w", io.EOF)}),
+		"struct { Err error }{\n"+
+			dumpIndent+`Err: error(*fmt.wrapError: "a: EOF"`+"\n"+
+			dumpIndent+`└── *errors.errorString: "EOF"),`+"\n"+
+			"}",
+	)
+
+	Equal(
+		t,
+		Dump(fmt.Errorf("a: %w", io.EOF), DumpHideTypes()),
+		`"a: EOF"`+"\n"+
+			`└── "EOF"`,
+	)
+
+	// Errors that don't wrap anything are dumped as usual
+	Equal(t, testDump(io.EOF), `&/* "EOF" */errors.errorString{`+"\n"+dumpIndent+`s: "EOF",`+"\n}")
+
+	msg, _ := checkNil(fmt.Errorf("a: %w", io.EOF))
+	Equal(
+		t,
+		msg,
+		"Expected nil, got:\n"+
+			dumpIndent+`*fmt.wrapError: "a: EOF"`+"\n"+
+			dumpIndent+`└── *errors.errorString: "EOF"`,
+	)
+}
+
 func TestDumpCircular(t *testing.T) {
 	t.Run("Pointer", func(t *testing.T) {
 		type circular struct {
@@ -270,3 +354,104 @@ func TestFmtBase10(t *testing.T) {
 	Equal(t, fmtInt64(math.MinInt64), "-9_223_372_036_854_775_808")
 	Equal(t, fmtUint64(math.MaxUint64), "18_446_744_073_709_551_615")
 }
+
+func TestDumpOptions(t *testing.T) {
+	type inner struct {
+		S []int
+	}
+
+	type outer struct {
+		I   inner
+		M   map[string]int
+		P   *int
+		Any any
+		Fn  func()
+	}
+
+	v := outer{
+		I: inner{S: []int{1, 2, 3}},
+		M: map[string]int{"a": -1, "b": 2},
+	}
+
+	Equal(t, Dump(1), "int(1)")
+	Equal(t, Dump(-255, DumpHex()), "int(-0xff)")
+	Equal(t, Dump(uint8(255), DumpHex()), "uint8(0xff)")
+	Equal(t, Dump(testStringer("a"), DumpNoAnnotations()), `check.testStringer("a")`)
+
+	Equal(
+		t,
+		Dump(v, DumpHideTypes(), DumpIndent("\t")),
+		"{\n"+
+			"\tI: {\n"+
+			"\t\tS: {\n"+
+			"\t\t\t1,\n"+
+			"\t\t\t2,\n"+
+			"\t\t\t3,\n"+
+			"\t\t},\n"+
+			"\t},\n"+
+			"\tM: {\n"+
+			"\t\t\"a\": -1,\n"+
+			"\t\t\"b\": 2,\n"+
+			"\t},\n"+
+			"\tP: nil,\n"+
+			"\tAny: nil,\n"+
+			"\tFn: nil,\n"+
+			"}",
+	)
+
+	Equal(
+		t,
+		Dump(v, DumpHideTypes(), DumpMaxDepth(2), DumpMaxElements(1), DumpIndent(" ")),
+		"{\n"+
+			" I: {\n"+
+			"  S: {...},\n"+
+			" },\n"+
+			" M: {\n"+
+			"  \"a\": -1,\n"+
+			"  ... 1 more elements ...\n"+
+			" },\n"+
+			" P: nil,\n"+
+			" Any: nil,\n"+
+			" Fn: nil,\n"+
+			"}",
+	)
+
+	Equal(
+		t,
+		Dump(make([]byte, 20), DumpMaxElements(10)),
+		"[]uint8{\n"+
+			dumpIndent+"0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,\n"+
+			dumpIndent+"0x00, 0x00,\n"+
+			dumpIndent+"... 10 more elements ...\n"+
+			"}",
+	)
+
+	var b strings.Builder
+	Nil(t, Fdump(&b, []int(nil)))
+	Equal(t, b.String(), "[]int(nil)")
+	Equal(t, Dump((*int)(nil)), "(*int)(nil)")
+	Equal(t, Dump(uintptr(0x10)), "(uintptr)(0x10)")
+	Equal(t, Dump(uintptr(0x10), DumpHideTypes()), "0x10")
+	Equal(t, Dump(any(1), DumpHideTypes()), "1")
+}
+
+func TestDumpElision(t *testing.T) {
+	big := make([]int, 50_000)
+
+	msg := dump(big, 0)
+	Contains(t, msg, "... 49_872 more elements ...")
+	NotContains(t, Dump(big), "more elements")
+
+	Equal(
+		t,
+		Dump("héllo", DumpMaxStringLen(2)),
+		`"h" ... 5 more bytes ...`,
+	)
+	Equal(
+		t,
+		Dump("héllo", DumpMaxStringLen(3)),
+		`"hé" ... 3 more bytes ...`,
+	)
+	Equal(t, Dump("héllo", DumpMaxStringLen(10)), `"héllo"`)
+	Contains(t, dump(strings.Repeat("a", 10_000), 0), "... 5_904 more bytes ...")
+}
@@ -0,0 +1,498 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/thatguystone/cog/check/internal/bipartite"
+)
+
+// An EqualOption customizes how [EqualWith] compares values.
+type EqualOption func(*equalConfig)
+
+type equalConfig struct {
+	ignore    map[string]struct{}
+	floatTol  float64
+	unordered bool
+	comparers map[reflect.Type]func(a, b reflect.Value) bool
+	eqMethods bool
+
+	// When set, b is a pattern: its zero values match anything, and its maps
+	// only need to be a subset of a's
+	partial bool
+
+	// How paths and values are shown in diffs
+	root     string
+	fmtKey   func(k reflect.Value) string
+	fmtValue func(v reflect.Value) string
+}
+
+// IgnoreFields skips the struct fields at the given paths. A path is a
+// dot-separated list of field names starting from the root value, eg.
+// "Address.Zip"; slice indexes, map keys, and pointers are transparent, so
+// "Users.Address.Zip" matches the Zip field of every element of Users.
+func IgnoreFields(paths ...string) EqualOption {
+	return func(cfg *equalConfig) {
+		for _, path := range paths {
+			cfg.ignore[path] = struct{}{}
+		}
+	}
+}
+
+// FloatTolerance considers floats (and the parts of complex numbers) equal if
+// they differ by no more than tol.
+func FloatTolerance(tol float64) EqualOption {
+	return func(cfg *equalConfig) {
+		cfg.floatTol = tol
+	}
+}
+
+// UnorderedSlices compares slices and arrays as multisets: they're equal if
+// they contain the same elements, in any order.
+func UnorderedSlices() EqualOption {
+	return func(cfg *equalConfig) {
+		cfg.unordered = true
+	}
+}
+
+// Comparer uses fn to compare all values of type T.
+func Comparer[T any](fn func(a, b T) bool) EqualOption {
+	return func(cfg *equalConfig) {
+		cfg.comparers[reflect.TypeFor[T]()] = func(a, b reflect.Value) bool {
+			// Nil interfaces don't assert to interface types; use T's zero
+			// value for them
+			av, _ := a.Interface().(T)
+			bv, _ := b.Interface().(T)
+			return fn(av, bv)
+		}
+	}
+}
+
+// EqualMethods compares values of any type T with an `Equal(T) bool` method
+// (eg. [time.Time]) using that method.
+func EqualMethods() EqualOption {
+	return func(cfg *equalConfig) {
+		cfg.eqMethods = true
+	}
+}
+
+type visit struct {
+	a, b unsafe.Pointer
+	t    reflect.Type
+}
+
+type pathStep struct {
+	field string // Name of the struct field, if this step is one
+	str   string
+}
+
+// A valueDiff is a mismatch found at a path in a value
+type valueDiff struct {
+	path     string
+	a, b     reflect.Value
+	note     string // If set, used instead of a and b to describe the mismatch
+	fmtValue func(v reflect.Value) string
+}
+
+type equalState struct {
+	cfg     equalConfig
+	path    []pathStep
+	visited map[visit]struct{}
+
+	// When set, comparisons continue after finding a mismatch and record
+	// every mismatch found in diffs
+	collect bool
+	diffs   []valueDiff
+}
+
+func newEqualState(opts []EqualOption) *equalState {
+	s := &equalState{
+		cfg: equalConfig{
+			ignore:    make(map[string]struct{}),
+			comparers: make(map[reflect.Type]func(a, b reflect.Value) bool),
+			fmtKey: func(k reflect.Value) string {
+				return "[" + fmt.Sprintf("%#v", k) + "]"
+			},
+			fmtValue: func(v reflect.Value) string {
+				return dumpValue(v, 0)
+			},
+		},
+		visited: make(map[visit]struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(&s.cfg)
+	}
+
+	return s
+}
+
+func (s *equalState) equal(a, b reflect.Value) bool {
+	if s.cfg.partial && (!b.IsValid() || b.IsZero()) {
+		return true
+	}
+
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() == b.IsValid() {
+			return true
+		}
+
+		return s.mismatch(a, b, "")
+	}
+
+	if a.Type() != b.Type() {
+		return s.mismatch(a, b, "")
+	}
+
+	if eq, ok := s.custom(a, b); ok {
+		return eq || s.mismatch(a, b, "")
+	}
+
+	if v, ok := makeVisit(a, b); ok {
+		if _, ok := s.visited[v]; ok {
+			return true
+		}
+
+		s.visited[v] = struct{}{}
+		defer delete(s.visited, v)
+	}
+
+	switch a.Kind() {
+	case reflect.Array:
+		return s.listEqual(a, b)
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() && !s.cfg.partial {
+			return s.mismatch(a, b, "")
+		}
+
+		return s.listEqual(a, b)
+	case reflect.Map:
+		return s.mapEqual(a, b)
+	case reflect.Struct:
+		return s.structEqual(a, b)
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() == b.IsNil() {
+				return true
+			}
+
+			return s.mismatch(a, b, "")
+		}
+
+		return s.equal(a.Elem(), b.Elem())
+	default:
+		return s.scalarEqual(a, b) || s.mismatch(a, b, "")
+	}
+}
+
+func (s *equalState) scalarEqual(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return s.floatEqual(a.Float(), b.Float())
+	case reflect.Complex64, reflect.Complex128:
+		ac, bc := a.Complex(), b.Complex()
+		return s.floatEqual(real(ac), real(bc)) && s.floatEqual(imag(ac), imag(bc))
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	case reflect.Func:
+		// Same as [reflect.DeepEqual]: funcs are only equal if both are nil
+		return a.IsNil() && b.IsNil()
+	default:
+		return false
+	}
+}
+
+// mismatch records a mismatch at the current path, if collecting, and always
+// returns false
+func (s *equalState) mismatch(a, b reflect.Value, note string) bool {
+	if s.collect {
+		s.diffs = append(s.diffs, valueDiff{
+			path:     s.pathString(),
+			a:        a,
+			b:        b,
+			note:     note,
+			fmtValue: s.cfg.fmtValue,
+		})
+	}
+
+	return false
+}
+
+func (s *equalState) push(step pathStep) {
+	s.path = append(s.path, step)
+}
+
+func (s *equalState) pop() {
+	s.path = s.path[:len(s.path)-1]
+}
+
+func (s *equalState) pathString() string {
+	if len(s.path) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(s.cfg.root)
+	for _, step := range s.path {
+		b.WriteString(step.str)
+	}
+
+	return b.String()
+}
+
+// custom runs any user-configured comparisons for the given values
+func (s *equalState) custom(a, b reflect.Value) (eq, ok bool) {
+	fn, hasFn := s.cfg.comparers[a.Type()]
+	if !hasFn && !s.cfg.eqMethods {
+		return
+	}
+
+	a, aok := interfaceable(a)
+	b, bok := interfaceable(b)
+	if !aok || !bok {
+		return
+	}
+
+	if hasFn {
+		return fn(a, b), true
+	}
+
+	m, hasM := a.Type().MethodByName("Equal")
+	if !hasM {
+		return
+	}
+
+	mt := m.Type
+	ok = mt.NumIn() == 2 &&
+		mt.In(1) == a.Type() &&
+		mt.NumOut() == 1 &&
+		mt.Out(0).Kind() == reflect.Bool
+	if !ok {
+		return
+	}
+
+	eq = m.Func.Call([]reflect.Value{a, b})[0].Bool()
+	return
+}
+
+func interfaceable(rv reflect.Value) (reflect.Value, bool) {
+	if rv.CanInterface() {
+		return rv, true
+	}
+
+	return forceCanInterface(rv)
+}
+
+// makeVisit creates a key to track visited references so that circular values
+// terminate
+func makeVisit(a, b reflect.Value) (v visit, ok bool) {
+	switch a.Kind() {
+	default:
+		return
+
+	case reflect.Map, reflect.Slice, reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return
+		}
+
+		v = visit{a.UnsafePointer(), b.UnsafePointer(), a.Type()}
+		ok = true
+		return
+	}
+}
+
+func (s *equalState) floatEqual(a, b float64) bool {
+	return a == b || math.Abs(a-b) <= s.cfg.floatTol
+}
+
+func (s *equalState) listEqual(a, b reflect.Value) bool {
+	if a.Len() != b.Len() {
+		note := fmt.Sprintf("len %d != %d", a.Len(), b.Len())
+		return s.mismatch(a, b, note)
+	}
+
+	if s.cfg.unordered {
+		return s.multisetEqual(a, b) || s.mismatch(a, b, "elements differ")
+	}
+
+	eq := true
+	for i := range a.Len() {
+		s.push(pathStep{str: "[" + strconv.Itoa(i) + "]"})
+		eq = s.equal(a.Index(i), b.Index(i)) && eq
+		s.pop()
+
+		if !eq && !s.collect {
+			return false
+		}
+	}
+
+	return eq
+}
+
+func (s *equalState) multisetEqual(a, b reflect.Value) bool {
+	// Failed matches while searching aren't mismatches
+	collect := s.collect
+	s.collect = false
+	defer func() {
+		s.collect = collect
+	}()
+
+	// With FloatTolerance or a Comparer, equality isn't transitive, so pairing
+	// elements greedily can miss a matching that exists
+	matches := make([][]bool, a.Len())
+	for i := range matches {
+		matches[i] = make([]bool, b.Len())
+		for j := range b.Len() {
+			matches[i][j] = s.equal(a.Index(i), b.Index(j))
+		}
+	}
+
+	return !slices.Contains(bipartite.Match(matches), -1)
+}
+
+func (s *equalState) mapEqual(a, b reflect.Value) bool {
+	if s.cfg.partial {
+		return s.subsetEqual(a, b)
+	}
+
+	if a.IsNil() != b.IsNil() {
+		return s.mismatch(a, b, "")
+	}
+
+	if a.Len() != b.Len() && !s.collect {
+		return false
+	}
+
+	eq := true
+	for _, kv := range sortMap(a) {
+		s.push(pathStep{str: s.cfg.fmtKey(kv.k)})
+
+		bv := b.MapIndex(kv.k)
+		if bv.IsValid() {
+			eq = s.equal(kv.v, bv) && eq
+		} else {
+			eq = s.mismatch(kv.v, bv, "unexpected key")
+		}
+
+		s.pop()
+
+		if !eq && !s.collect {
+			return false
+		}
+	}
+
+	if a.Len() == b.Len() && eq {
+		return true
+	}
+
+	for _, kv := range sortMap(b) {
+		if !a.MapIndex(kv.k).IsValid() {
+			s.push(pathStep{str: s.cfg.fmtKey(kv.k)})
+			eq = s.mismatch(reflect.Value{}, kv.v, "missing key")
+			s.pop()
+		}
+	}
+
+	return eq
+}
+
+// subsetEqual checks that every key in b is in a, with a matching value
+func (s *equalState) subsetEqual(a, b reflect.Value) bool {
+	eq := true
+	for _, kv := range sortMap(b) {
+		s.push(pathStep{str: s.cfg.fmtKey(kv.k)})
+
+		av := a.MapIndex(kv.k)
+		if av.IsValid() {
+			eq = s.equal(av, kv.v) && eq
+		} else {
+			eq = s.mismatch(av, kv.v, "missing key")
+		}
+
+		s.pop()
+
+		if !eq && !s.collect {
+			return false
+		}
+	}
+
+	return eq
+}
+
+func (s *equalState) structEqual(a, b reflect.Value) bool {
+	rt := a.Type()
+
+	eq := true
+	for i := range rt.NumField() {
+		name := rt.Field(i).Name
+
+		s.push(pathStep{field: name, str: "." + name})
+		eq = (s.ignored() || s.equal(a.Field(i), b.Field(i))) && eq
+		s.pop()
+
+		if !eq && !s.collect {
+			return false
+		}
+	}
+
+	return eq
+}
+
+func (s *equalState) ignored() bool {
+	if len(s.cfg.ignore) == 0 {
+		return false
+	}
+
+	var fields []string
+	for _, step := range s.path {
+		if step.field != "" {
+			fields = append(fields, step.field)
+		}
+	}
+
+	_, ok := s.cfg.ignore[strings.Join(fields, ".")]
+	return ok
+}
+
+// diffValues finds every path where g and e differ
+func diffValues(g, e any, opts []EqualOption) []valueDiff {
+	s := newEqualState(opts)
+	s.collect = true
+	s.equal(reflect.ValueOf(g), reflect.ValueOf(e))
+	return s.diffs
+}
+
+func (vd valueDiff) String() string {
+	if vd.note != "" {
+		return vd.path + ": " + vd.note
+	}
+
+	gs := vd.fmtValue(vd.a)
+	es := vd.fmtValue(vd.b)
+	if strings.Contains(gs, "\n") || strings.Contains(es, "\n") {
+		return vd.path + ": values differ"
+	}
+
+	return vd.path + ": " + gs + " != " + es
+}
+
+func checkEqualWith(g, e any, opts ...EqualOption) (string, bool) {
+	if newEqualState(opts).equal(reflect.ValueOf(g), reflect.ValueOf(e)) {
+		return "", true
+	}
+
+	return equalMsg(g, e, opts...), false
+}
@@ -0,0 +1,201 @@
+package check
+
+import (
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testUser struct {
+	Name    string
+	Created time.Time
+	Address testAddress
+	Tags    []string
+	id      int
+}
+
+type testAddress struct {
+	Street string
+	Zip    string
+}
+
+func TestCheckEqualWith(t *testing.T) {
+	t.Run("Basic", func(t *testing.T) {
+		testCheck(checkEqualWith(1, 1))(t, true)
+		testCheck(checkEqualWith(1, 2))(t, false)
+		testCheck(checkEqualWith(int8(1), int16(1)))(t, false)
+		testCheck(checkEqualWith(nil, nil))(t, true)
+		testCheck(checkEqualWith(nil, 1))(t, false)
+		testCheck(checkEqualWith([]int(nil), []int{}))(t, false)
+		testCheck(checkEqualWith(map[int]int{1: 1}, map[int]int{1: 1}))(t, true)
+		testCheck(checkEqualWith(map[int]int{1: 1}, map[int]int{2: 1}))(t, false)
+		testCheck(checkEqualWith(math.NaN(), math.NaN()))(t, false)
+		testCheck(checkEqualWith(func() {}, func() {}))(t, false)
+		testCheck(checkEqualWith(testUser{id: 1}, testUser{id: 2}))(t, false)
+	})
+
+	t.Run("IgnoreFields", func(t *testing.T) {
+		var (
+			a = []testUser{{Name: "a", Created: time.Now(), Address: testAddress{Zip: "1"}}}
+			b = []testUser{{Name: "a", Address: testAddress{Zip: "2"}}}
+		)
+
+		testCheck(checkEqualWith(a, b, IgnoreFields("Created")))(t, false)
+		testCheck(checkEqualWith(a, b, IgnoreFields("Created", "Address.Zip")))(t, true)
+		testCheck(checkEqualWith(a, b, IgnoreFields("Created", "Zip")))(t, false)
+	})
+
+	t.Run("FloatTolerance", func(t *testing.T) {
+		x, y := 0.1, 0.2
+
+		testCheck(checkEqualWith(x+y, 0.3, FloatTolerance(1e-9)))(t, true)
+		testCheck(checkEqualWith(x+y, 0.3))(t, false)
+		testCheck(checkEqualWith(complex(x+y, 1), complex(0.3, 1), FloatTolerance(1e-9)))(t, true)
+		testCheck(checkEqualWith(1.0, 1.1, FloatTolerance(1e-9)))(t, false)
+	})
+
+	t.Run("UnorderedSlices", func(t *testing.T) {
+		testCheck(checkEqualWith([]int{1, 2, 2}, []int{2, 1, 2}, UnorderedSlices()))(t, true)
+		testCheck(checkEqualWith([]int{1, 2, 2}, []int{2, 1, 1}, UnorderedSlices()))(t, false)
+		testCheck(checkEqualWith([]int{1, 2}, []int{2, 1}))(t, false)
+		testCheck(checkEqualWith([]int{1}, []int{1, 2}, UnorderedSlices()))(t, false)
+
+		// Greedily pairing 1.0 with 1.05 would leave 1.1 unmatched
+		testCheck(
+			checkEqualWith(
+				[]float64{1.0, 1.1},
+				[]float64{1.05, 0.95},
+				UnorderedSlices(),
+				FloatTolerance(0.15),
+			),
+		)(t, true)
+	})
+
+	t.Run("Comparer", func(t *testing.T) {
+		fold := Comparer(strings.EqualFold)
+
+		testCheck(checkEqualWith([]string{"A"}, []string{"a"}, fold))(t, true)
+		testCheck(checkEqualWith([]string{"A"}, []string{"b"}, fold))(t, false)
+		testCheck(checkEqualWith(testUser{Name: "A"}, testUser{Name: "a"}, fold))(t, true)
+
+		type withErr struct{ E error }
+
+		sameMsg := Comparer(func(a, b error) bool {
+			return a == nil && b == nil || a != nil && b != nil && a.Error() == b.Error()
+		})
+
+		testCheck(checkEqualWith(withErr{}, withErr{E: io.EOF}, sameMsg))(t, false)
+		testCheck(checkEqualWith(withErr{}, withErr{}, sameMsg))(t, true)
+		testCheck(checkEqualWith(withErr{E: errors.New("EOF")}, withErr{E: io.EOF}, sameMsg))(t, true)
+	})
+
+	t.Run("EqualMethods", func(t *testing.T) {
+		var (
+			now = time.Now()
+			utc = now.UTC()
+		)
+
+		testCheck(checkEqualWith(now, utc))(t, false)
+		testCheck(checkEqualWith(now, utc, EqualMethods()))(t, true)
+		testCheck(checkEqualWith(now, utc.Add(1), EqualMethods()))(t, false)
+		testCheck(checkEqualWith(1, 1, EqualMethods()))(t, true)
+	})
+
+	t.Run("Circular", func(t *testing.T) {
+		type circular struct {
+			Next *circular
+			V    int
+		}
+
+		var (
+			a = &circular{V: 1}
+			b = &circular{V: 1}
+		)
+
+		a.Next = a
+		b.Next = b
+
+		testCheck(checkEqualWith(a, b))(t, true)
+
+		b.V = 2
+		testCheck(checkEqualWith(a, b))(t, false)
+	})
+}
+
+func TestDiffValues(t *testing.T) {
+	paths := func(g, e any, opts ...EqualOption) (paths []string) {
+		for _, vd := range diffValues(g, e, opts) {
+			paths = append(paths, vd.String())
+		}
+
+		return
+	}
+
+	a := []testUser{
+		{Name: "a", Tags: []string{"x"}, Address: testAddress{Zip: "1"}},
+		{Name: "b"},
+	}
+	b := []testUser{
+		{Name: "a", Tags: []string{"x", "y"}, Address: testAddress{Zip: "2"}},
+		{Name: "c"},
+	}
+
+	Equal(t, paths(a, b), []string{
+		`[0].Address.Zip: "1" != "2"`,
+		`[0].Tags: len 1 != 2`,
+		`[1].Name: "b" != "c"`,
+	})
+	Equal(t, paths(a, b, IgnoreFields("Address.Zip")), []string{
+		`[0].Tags: len 1 != 2`,
+		`[1].Name: "b" != "c"`,
+	})
+	Equal(t, paths(a, a), []string(nil))
+
+	Equal(
+		t,
+		paths(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2, "c": 3}),
+		[]string{
+			`["a"]: int(1) != int(2)`,
+			`["b"]: unexpected key`,
+			`["c"]: missing key`,
+		},
+	)
+	Equal(
+		t,
+		paths([]any{testUser{}}, []any{1}),
+		[]string{`[0]: values differ`},
+	)
+	Equal(
+		t,
+		paths([]int{1, 2}, []int{2, 3}, UnorderedSlices()),
+		[]string{`: elements differ`},
+	)
+}
+
+func TestEqualMsgPaths(t *testing.T) {
+	msg := equalMsg(
+		testUser{Address: testAddress{Zip: "1"}},
+		testUser{Address: testAddress{Zip: "2"}},
+	)
+	Contains(t, msg, `.Address.Zip: "1" != "2"`)
+
+	msg = equalMsg(
+		testUser{Address: testAddress{Zip: "1"}},
+		testUser{Address: testAddress{Zip: "2"}},
+		IgnoreFields("Address.Zip"),
+	)
+	NotContains(t, msg, `.Address.Zip`)
+
+	many := make([]int, 20)
+	for i := range many {
+		many[i] = i + 1
+	}
+
+	msg = equalMsg(many, make([]int, 20))
+	Contains(t, msg, "... 4 more differences ...")
+	Contains(t, msg, "[15]: ")
+	NotContains(t, msg, "[16]: ")
+}
@@ -0,0 +1,129 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/thatguystone/cog/textwrap"
+)
+
+func checkErrorAs[E error](err error) (target E, msg string, ok bool) {
+	if errors.As(err, &target) {
+		ok = true
+		return
+	}
+
+	msg = fmt.Sprintf("Expected error tree to contain a %s:\n", reflect.TypeFor[E]()) +
+		indentErrorTree(err, 1)
+	return
+}
+
+// unwrapError gets the errors directly wrapped by err
+func unwrapError(err error) []error {
+	switch err := err.(type) {
+	case interface{ Unwrap() []error }:
+		return err.Unwrap()
+
+	case interface{ Unwrap() error }:
+		if inner := err.Unwrap(); inner != nil {
+			return []error{inner}
+		}
+	}
+
+	return nil
+}
+
+// errorTree renders err and everything it wraps, including each branch of
+// joined errors, as a tree with each error's type and message.
+func errorTree(err error) string {
+	return formatErrorTree(err, func(err error) string {
+		return fmt.Sprintf("%T: %s", err, dump(err.Error(), 0))
+	})
+}
+
+// formatErrorTree renders the tree of errors wrapped by err, using fmtNode to
+// describe each one.
+func formatErrorTree(err error, fmtNode func(err error) string) string {
+	b := new(strings.Builder)
+	writeErrorNode(b, err, "", "", fmtNode)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func writeErrorNode(
+	b *strings.Builder,
+	err error,
+	prefix, childPrefix string,
+	fmtNode func(err error) string,
+) {
+	b.WriteString(prefix)
+
+	if err == nil {
+		b.WriteString("nil\n")
+		return
+	}
+
+	b.WriteString(fmtNode(err))
+	b.WriteByte('\n')
+
+	children := unwrapError(err)
+	for i, child := range children {
+		if i == len(children)-1 {
+			writeErrorNode(b, child, childPrefix+"└── ", childPrefix+"    ", fmtNode)
+		} else {
+			writeErrorNode(b, child, childPrefix+"├── ", childPrefix+"│   ", fmtNode)
+		}
+	}
+}
+
+func indentErrorTree(err error, indent int) string {
+	return textwrap.Indent(errorTree(err), strings.Repeat(dumpIndent, indent))
+}
+
+// errorMsg describes a failed check of err against what
+func errorMsg(title, what, desc string, err error) string {
+	return title + ":\n" +
+		dumpIndent + upperFirst(what) + ":\n" +
+		textwrap.Indent(desc, dumpIndent+dumpIndent) +
+		"\n" +
+		dumpIndent + "Error:\n" +
+		indentErrorTree(err, 2)
+}
+
+func checkErrorContains(err error, substr string) (string, bool) {
+	if err != nil && strings.Contains(err.Error(), substr) {
+		return "", true
+	}
+
+	title := "Expected error message to contain substring"
+	return errorMsg(title, "substring", dump(substr, 0), err), false
+}
+
+func checkErrorMatches(err error, re any) (string, bool) {
+	r, msg := toRegexp(re)
+	if msg != "" {
+		return msg, false
+	}
+
+	if err != nil && r.MatchString(err.Error()) {
+		return "", true
+	}
+
+	title := "Expected error message to match regexp"
+	return errorMsg(title, "regexp", dump(r.String(), 0), err), false
+}
+
+func checkErrorIsAny(err error, targets ...error) (string, bool) {
+	trees := make([]string, len(targets))
+	for i, target := range targets {
+		if errors.Is(err, target) {
+			return "", true
+		}
+
+		trees[i] = errorTree(target)
+	}
+
+	title := "Expected error tree to contain any of the targets"
+	return errorMsg(title, "targets", strings.Join(trees, "\n"), err), false
+}
@@ -0,0 +1,93 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"testing"
+)
+
+func testErrorTree() error {
+	return fmt.Errorf(
+		"load: %w",
+		errors.Join(
+			&os.PathError{Op: "open", Path: "a", Err: fs.ErrNotExist},
+			io.EOF,
+		),
+	)
+}
+
+func TestErrorTree(t *testing.T) {
+	Equal(t, errorTree(nil), "nil")
+	Equal(t, errorTree(io.EOF), `*errors.errorString: "EOF"`)
+	Equal(
+		t,
+		errorTree(testErrorTree()),
+		`*fmt.wrapError: "load: open a: file does not exist\nEOF"`+"\n"+
+			`└── *errors.joinError: "open a: file does not exist\nEOF"`+"\n"+
+			`    ├── *fs.PathError: "open a: file does not exist"`+"\n"+
+			`    │   └── *errors.errorString: "file does not exist"`+"\n"+
+			`    └── *errors.errorString: "EOF"`,
+	)
+}
+
+func TestCheckErrorAs(t *testing.T) {
+	pe, _, ok := checkErrorAs[*fs.PathError](testErrorTree())
+	True(t, ok)
+	Equal(t, pe.Path, "a")
+
+	_, msg, ok := checkErrorAs[*os.LinkError](testErrorTree())
+	False(t, ok)
+	NotEqual(t, msg, "")
+
+	_, msg, ok = checkErrorAs[*os.LinkError](nil)
+	False(t, ok)
+	Equal(t, msg, "Expected error tree to contain a *os.LinkError:\n"+dumpIndent+"nil")
+
+	tb := new(testTB)
+	pe, ok = ErrorAs[*fs.PathError](tb, testErrorTree())
+	True(t, ok)
+	Equal(t, pe.Op, "open")
+	Equal(t, len(tb.errors), 0)
+
+	_, ok = ErrorAs[*os.LinkError](tb, io.EOF)
+	False(t, ok)
+	Equal(t, len(tb.errors), 1)
+
+	MustErrorAs[*os.LinkError](tb, io.EOF)
+	Equal(t, len(tb.fatals), 1)
+}
+
+func TestCheckErrorContains(t *testing.T) {
+	testCheck(checkErrorContains(testErrorTree(), "does not exist"))(t, true)
+	testCheck(checkErrorContains(testErrorTree(), "nope"))(t, false)
+	testCheck(checkErrorContains(nil, ""))(t, false)
+
+	msg, _ := checkErrorContains(io.EOF, "nope")
+	Equal(
+		t,
+		msg,
+		"Expected error message to contain substring:\n"+
+			dumpIndent+"Substring:\n"+
+			dumpIndent+dumpIndent+`"nope"`+"\n"+
+			dumpIndent+"Error:\n"+
+			dumpIndent+dumpIndent+`*errors.errorString: "EOF"`,
+	)
+}
+
+func TestCheckErrorMatches(t *testing.T) {
+	testCheck(checkErrorMatches(testErrorTree(), `^load: `))(t, true)
+	testCheck(checkErrorMatches(testErrorTree(), `^open`))(t, false)
+	testCheck(checkErrorMatches(nil, ``))(t, false)
+	testCheck(checkErrorMatches(io.EOF, `(`))(t, false)
+}
+
+func TestCheckErrorIsAny(t *testing.T) {
+	testCheck(checkErrorIsAny(testErrorTree(), fs.ErrClosed, io.EOF))(t, true)
+	testCheck(checkErrorIsAny(testErrorTree(), fs.ErrNotExist))(t, true)
+	testCheck(checkErrorIsAny(testErrorTree(), fs.ErrClosed))(t, false)
+	testCheck(checkErrorIsAny(testErrorTree()))(t, false)
+	testCheck(checkErrorIsAny(nil, nil))(t, true)
+}
@@ -0,0 +1,136 @@
+package check
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DumpFormatter can be implemented by types that want to control how they're
+// dumped. The result is used in place of the type's contents.
+type DumpFormatter interface {
+	CheckDump() string
+}
+
+type formatFunc func(rv reflect.Value) string
+
+var formatters = struct {
+	mtx sync.RWMutex
+	fns map[reflect.Type]formatFunc
+}{
+	fns: make(map[reflect.Type]formatFunc),
+}
+
+// RegisterFormatter registers fn to format every value of type T when dumped,
+// replacing any previous formatter for T. The result is used in place of the
+// value's contents, eg. `time.Time(2006-01-02T15:04:05Z)`.
+func RegisterFormatter[T any](fn func(T) string) {
+	formatters.mtx.Lock()
+	defer formatters.mtx.Unlock()
+
+	formatters.fns[reflect.TypeFor[T]()] = func(rv reflect.Value) string {
+		return fn(rv.Interface().(T))
+	}
+}
+
+func init() {
+	RegisterFormatter(formatTime)
+	RegisterFormatter(time.Duration.String)
+	RegisterFormatter((*time.Location).String)
+	RegisterFormatter((*big.Int).String)
+	RegisterFormatter(func(f *big.Float) string {
+		return f.Text('g', -1)
+	})
+	RegisterFormatter((*big.Rat).RatString)
+	RegisterFormatter(net.IP.String)
+	RegisterFormatter(net.IPMask.String)
+	RegisterFormatter((*net.IPNet).String)
+	RegisterFormatter(net.HardwareAddr.String)
+	RegisterFormatter(netip.Addr.String)
+	RegisterFormatter(netip.AddrPort.String)
+	RegisterFormatter(netip.Prefix.String)
+	RegisterFormatter((*url.URL).String)
+	RegisterFormatter((*regexp.Regexp).String)
+}
+
+// formatTime formats t with everything that [time.Time.Equal] ignores but
+// reflect.DeepEqual doesn't, so that times that aren't deeply equal don't format
+// the same.
+func formatTime(t time.Time) string {
+	s := t.Format(time.RFC3339Nano)
+
+	if loc := t.Location(); loc != time.UTC {
+		s += " " + loc.String()
+	}
+
+	// The monotonic clock reading is only exposed via String()
+	str := t.String()
+	if i := strings.LastIndex(str, " m="); i >= 0 {
+		s += str[i:]
+	}
+
+	return s
+}
+
+// getFormatter finds the formatter for rv, if there is one
+func getFormatter(rv reflect.Value) (formatFunc, bool) {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		// Leave nil values to the standard formatting
+		if rv.IsNil() {
+			return nil, false
+		}
+	}
+
+	formatters.mtx.RLock()
+	fn, ok := formatters.fns[rv.Type()]
+	formatters.mtx.RUnlock()
+
+	if ok {
+		return fn, true
+	}
+
+	if rv.Type().Implements(reflect.TypeFor[DumpFormatter]()) {
+		return func(rv reflect.Value) string {
+			return rv.Interface().(DumpFormatter).CheckDump()
+		}, true
+	}
+
+	return nil, false
+}
+
+// fmtCustom formats rv with a registered formatter, if there is one
+func (d *dumper) fmtCustom(rv reflect.Value) bool {
+	fn, ok := getFormatter(rv)
+	if !ok {
+		return false
+	}
+
+	rv, ok = interfaceable(rv)
+	if !ok {
+		return false
+	}
+
+	str := func() (str string) {
+		defer func() {
+			if r := recover(); r != nil {
+				str = fmt.Sprintf("(PANIC=%q)", r)
+			}
+		}()
+
+		return fn(rv)
+	}()
+
+	d.openType(rv)
+	d.buf.WriteString(str)
+	d.closeType()
+
+	return true
+}
@@ -0,0 +1,72 @@
+package check
+
+import (
+	"math/big"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testFormatted struct {
+	a, b int
+}
+
+func (testFormatted) CheckDump() string {
+	return "formatted"
+}
+
+type testFormatPanics struct{}
+
+func TestFormatters(t *testing.T) {
+	tm := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	Equal(t, testDump(tm), "time.Time(2024-01-02T03:04:05.000000006Z)")
+	Equal(
+		t,
+		testDump(tm.In(time.FixedZone("EST", -5*60*60))),
+		"time.Time(2024-01-01T22:04:05.000000006-05:00 EST)",
+	)
+	Equal(t, testDump(time.Second), "time.Duration(1s)")
+	Equal(t, testDump(big.NewInt(123)), "*big.Int(123)")
+	Equal(t, testDump((*big.Int)(nil)), "(*big.Int)(nil)")
+	Equal(t, testDump(net.IPv4(127, 0, 0, 1)), "net.IP(127.0.0.1)")
+	Equal(t, testDump(net.IP(nil)), `/* "<nil>" */net.IP(nil)`)
+	Equal(t, testDump(testFormatted{}), "check.testFormatted(formatted)")
+	Equal(t, Dump(time.Second, DumpHideTypes()), "1s")
+
+	// Formatters need an interface, which unexported fields only have when
+	// they can be forced to (ie. not under purego)
+	unexported := struct{ t time.Time }{tm}
+	if _, ok := forceCanInterface(reflect.ValueOf(unexported).Field(0)); ok {
+		Equal(
+			t,
+			testDump(unexported),
+			"struct { t time.Time }{\n"+
+				dumpIndent+"t: time.Time(2024-01-02T03:04:05.000000006Z),\n"+
+				"}",
+		)
+	} else {
+		Contains(t, testDump(unexported), "t: time.Time{\n")
+	}
+}
+
+func TestFormatTimeMonotonic(t *testing.T) {
+	now := time.Now()
+
+	Contains(t, testDump(now), " m=+")
+	NotContains(t, testDump(now.Round(0)), " m=")
+	NotEqual(t, testDump(now), testDump(now.Round(0)))
+
+	msg, ok := checkEqual(now, now.Round(0))
+	False(t, ok)
+	Contains(t, msg, " m=+")
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	RegisterFormatter(func(testFormatPanics) string {
+		panic("oops")
+	})
+
+	Equal(t, testDump(testFormatPanics{}), `check.testFormatPanics((PANIC="oops"))`)
+}
@@ -0,0 +1,99 @@
+package check
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var update = flag.Bool(
+	"check.update",
+	false,
+	"update golden files and snapshots instead of checking them",
+)
+
+// Updating determines if golden files and snapshots should be updated instead
+// of checked. This is true if the `-check.update` flag is given, or if the
+// CHECK_UPDATE environment variable is true.
+func Updating() bool {
+	if *update {
+		return true
+	}
+
+	env, _ := strconv.ParseBool(os.Getenv("CHECK_UPDATE"))
+	return env
+}
+
+// NamedError is an [Error] that knows the name of the running test.
+type NamedError interface {
+	Error
+	Name() string
+}
+
+// NamedFatal is a [Fatal] that knows the name of the running test.
+type NamedFatal interface {
+	Fatal
+	Name() string
+}
+
+func goldenPath(testName, name string) string {
+	return filepath.Join("testdata", filepath.FromSlash(testName), name+".golden")
+}
+
+func goldenBytes(v any) []byte {
+	switch v := v.(type) {
+	case string:
+		return []byte(v)
+	case []byte:
+		return v
+	default:
+		return []byte(Dump(v) + "\n")
+	}
+}
+
+func checkGolden(path string, got any, update bool) (string, bool) {
+	gb := goldenBytes(got)
+
+	if update {
+		err := os.MkdirAll(filepath.Dir(path), 0o750)
+		if err == nil {
+			err = os.WriteFile(path, gb, 0o640)
+		}
+
+		if err != nil {
+			return "Failed to update golden file:\n" + dump(err, 1), false
+		}
+
+		return "", true
+	}
+
+	eb, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			msg := "Golden file " + path + " does not exist, " +
+				"run with -check.update to create it"
+			return msg, false
+		}
+
+		return "Failed to read golden file:\n" + dump(err, 1), false
+	}
+
+	if bytes.Equal(gb, eb) {
+		return "", true
+	}
+
+	b := new(strings.Builder)
+	b.WriteString("Golden file " + path + " does not match:\n")
+	writeLineDiff(
+		b,
+		strings.Split(string(gb), "\n"),
+		strings.Split(string(eb), "\n"),
+	)
+
+	return b.String(), false
+}
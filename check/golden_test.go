@@ -0,0 +1,63 @@
+package check
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGoldenPath(t *testing.T) {
+	Equal(
+		t,
+		goldenPath("TestGolden/Sub", "out"),
+		filepath.Join("testdata", "TestGolden", "Sub", "out.golden"),
+	)
+}
+
+func TestUpdating(t *testing.T) {
+	t.Setenv("CHECK_UPDATE", "1")
+	True(t, Updating())
+
+	t.Setenv("CHECK_UPDATE", "")
+	False(t, Updating())
+}
+
+func TestCheckGolden(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestCheckGolden", "out.golden")
+
+	msg, ok := checkGolden(path, "test", false)
+	False(t, ok)
+	Contains(t, msg, "does not exist")
+
+	testCheck(checkGolden(path, "test\nlines", true))(t, true)
+	testCheck(checkGolden(path, "test\nlines", false))(t, true)
+	testCheck(checkGolden(path, []byte("test\nlines"), false))(t, true)
+
+	msg, ok = checkGolden(path, "test\nline", false)
+	False(t, ok)
+	Contains(t, msg, "- line")
+	Contains(t, msg, "+ lines")
+
+	testCheck(checkGolden(path, []int{1, 2}, true))(t, true)
+	testCheck(checkGolden(path, []int{1, 2}, false))(t, true)
+	testCheck(checkGolden(path, []int{1, 3}, false))(t, false)
+
+	b, err := os.ReadFile(path)
+	MustNil(t, err)
+	Equal(t, string(b), testDump([]int{1, 2})+"\n")
+
+	testCheck(checkGolden(filepath.Dir(path), "", false))(t, false)
+	testCheck(checkGolden(filepath.Join(path, "nope"), "", true))(t, false)
+}
+
+func TestGolden(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("CHECK_UPDATE", "true")
+
+	Golden(t, "out", "golden")
+	New(t).Golden("out", "golden")
+
+	t.Setenv("CHECK_UPDATE", "")
+	MustGolden(t, "out", "golden")
+	New(t).Must().Golden("out", "golden")
+}
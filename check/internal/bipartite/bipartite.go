@@ -0,0 +1,39 @@
+// Package bipartite finds matchings in bipartite graphs.
+package bipartite
+
+// Match finds a maximum matching between two sets, a and b, where
+// matches[i][j] is set if a[i] can be paired with b[j]. It returns the element
+// of a matched to each element of b, or -1 if there's none.
+func Match(matches [][]bool) []int {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	aOf := make([]int, len(matches[0]))
+	for j := range aOf {
+		aOf[j] = -1
+	}
+
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for j, ok := range matches[i] {
+			if !ok || seen[j] {
+				continue
+			}
+
+			seen[j] = true
+			if aOf[j] < 0 || augment(aOf[j], seen) {
+				aOf[j] = i
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i := range matches {
+		augment(i, make([]bool, len(aOf)))
+	}
+
+	return aOf
+}
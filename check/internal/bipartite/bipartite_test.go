@@ -0,0 +1,31 @@
+package bipartite_test
+
+import (
+	"testing"
+
+	"github.com/thatguystone/cog/check"
+	"github.com/thatguystone/cog/check/internal/bipartite"
+)
+
+func TestMatch(t *testing.T) {
+	check.Equal(t, len(bipartite.Match(nil)), 0)
+
+	// Greedily pairing a[0] with b[0] would leave a[1] unmatched
+	check.Equal(
+		t,
+		bipartite.Match([][]bool{
+			{true, true},
+			{true, false},
+		}),
+		[]int{1, 0},
+	)
+
+	check.Equal(
+		t,
+		bipartite.Match([][]bool{
+			{true, false},
+			{true, false},
+		}),
+		[]int{0, -1},
+	)
+}
@@ -0,0 +1,18 @@
+// Package failmsg shares how package check builds failure messages with its
+// subpackages, without making any of it part of check's API.
+package failmsg
+
+// Indent is the indentation used for each level of nesting in failure
+// messages.
+const Indent = "    "
+
+// These are set by package check, which is always initialized before any of its
+// subpackages that use them.
+var (
+	// Dump formats v as it's shown in failure messages, with every line
+	// indented by depth levels of Indent.
+	Dump func(v any, depth int) string
+
+	// Equal checks that g and e are equal, as in check.Equal.
+	Equal func(g, e any) (string, bool)
+)
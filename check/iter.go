@@ -0,0 +1,157 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// maxSeqLen caps how many values are taken from a seq, in case it's infinite
+const maxSeqLen = 1 << 16
+
+// A seqPair is a pair of values yielded by an [iter.Seq2]
+type seqPair struct {
+	K, V any
+}
+
+// seqArity gets the number of values yielded by t if it's shaped like an
+// [iter.Seq] (1) or [iter.Seq2] (2), or 0 if it's neither.
+func seqArity(t reflect.Type) int {
+	if t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 0 {
+		return 0
+	}
+
+	yield := t.In(0)
+	if yield.Kind() != reflect.Func ||
+		yield.NumOut() != 1 ||
+		yield.Out(0).Kind() != reflect.Bool {
+		return 0
+	}
+
+	switch n := yield.NumIn(); n {
+	case 1, 2:
+		return n
+	default:
+		return 0
+	}
+}
+
+// isSeq checks if rv is a non-nil seq
+func isSeq(rv reflect.Value) bool {
+	return rv.Kind() == reflect.Func && !rv.IsNil() && seqArity(rv.Type()) > 0
+}
+
+// rangeSeq calls fn with the values yielded by the seq rv until it returns
+// false; k is only valid for an [iter.Seq2]. If the seq yields more than limit
+// values, it's stopped and more is true.
+func rangeSeq(rv reflect.Value, limit int, fn func(k, v reflect.Value) bool) (more bool) {
+	var (
+		n    = 0
+		yt   = rv.Type().In(0)
+		cont = reflect.ValueOf(true).Convert(yt.Out(0))
+		stop = reflect.ValueOf(false).Convert(yt.Out(0))
+	)
+
+	yield := reflect.MakeFunc(yt, func(args []reflect.Value) []reflect.Value {
+		if n == limit {
+			more = true
+			return []reflect.Value{stop}
+		}
+
+		n++
+
+		var k, v reflect.Value
+		if len(args) == 1 {
+			v = args[0]
+		} else {
+			k, v = args[0], args[1]
+		}
+
+		if !fn(k, v) {
+			return []reflect.Value{stop}
+		}
+
+		return []reflect.Value{cont}
+	})
+
+	rv.Call([]reflect.Value{yield})
+	return
+}
+
+// collectSeq gets up to limit values yielded by the seq rv
+func collectSeq(rv reflect.Value, limit int) (keys, vals []reflect.Value, more bool) {
+	more = rangeSeq(rv, limit, func(k, v reflect.Value) bool {
+		if k.IsValid() {
+			keys = append(keys, k)
+		}
+
+		vals = append(vals, v)
+		return true
+	})
+
+	return
+}
+
+func seqTooLongMsg(v any) string {
+	return fmt.Sprintf("Gave up on %T after it yielded %d values", v, maxSeqLen)
+}
+
+// seqForEqual collects the values from v, if it's a seq, so that it can be
+// compared to other. An [iter.Seq] becomes a slice; an [iter.Seq2] becomes a
+// map if other is a map, and a slice of seqPairs otherwise. An [iter.Seq2]
+// that yields the same key twice can't be a map, so it fails.
+func seqForEqual(v, other any) (any, string) {
+	rv := reflect.ValueOf(v)
+	if !isSeq(rv) {
+		return v, ""
+	}
+
+	keys, vals, more := collectSeq(rv, maxSeqLen)
+	if more {
+		return nil, seqTooLongMsg(v)
+	}
+
+	yt := rv.Type().In(0)
+
+	switch {
+	case yt.NumIn() == 1:
+		s := reflect.MakeSlice(reflect.SliceOf(yt.In(0)), 0, len(vals))
+		return reflect.Append(s, vals...).Interface(), ""
+
+	case reflect.ValueOf(other).Kind() == reflect.Map && yt.In(0).Comparable():
+		m := reflect.MakeMapWithSize(reflect.MapOf(yt.In(0), yt.In(1)), len(vals))
+		for i, k := range keys {
+			if m.MapIndex(k).IsValid() {
+				return nil, fmt.Sprintf(
+					"%T yielded the same key more than once:\n%s",
+					v, dump(k.Interface(), 1))
+			}
+
+			m.SetMapIndex(k, vals[i])
+		}
+
+		return m.Interface(), ""
+
+	default:
+		pairs := make([]seqPair, len(vals))
+		for i, k := range keys {
+			pairs[i] = seqPair{k.Interface(), vals[i].Interface()}
+		}
+
+		return pairs, ""
+	}
+}
+
+// seqsForEqual collects the values from g and e if either is a seq
+func seqsForEqual(g, e any) (any, any, string) {
+	gs, msg := seqForEqual(g, e)
+	if msg != "" {
+		return nil, nil, msg
+	}
+
+	es, msg := seqForEqual(e, g)
+	if msg != "" {
+		return nil, nil, msg
+	}
+
+	return gs, es, ""
+}
@@ -0,0 +1,61 @@
+package check
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSeqArity(t *testing.T) {
+	Equal(t, seqArity(reflect.TypeOf(slices.Values([]int{}))), 1)
+	Equal(t, seqArity(reflect.TypeOf(maps.All(map[int]int{}))), 2)
+	Equal(t, seqArity(reflect.TypeOf(func() {})), 0)
+	Equal(t, seqArity(reflect.TypeOf(func(int) {})), 0)
+	Equal(t, seqArity(reflect.TypeOf(func(func(int)) {})), 0)
+	Equal(t, seqArity(reflect.TypeOf(func(func() bool) {})), 0)
+	Equal(t, seqArity(reflect.TypeOf(1)), 0)
+}
+
+func TestCollectSeq(t *testing.T) {
+	_, vals, more := collectSeq(reflect.ValueOf(slices.Values([]int{1, 2})), 10)
+	False(t, more)
+	Equal(t, len(vals), 2)
+
+	keys, vals, more := collectSeq(reflect.ValueOf(slices.All([]string{"a"})), 10)
+	False(t, more)
+	Equal(t, keys[0].Interface(), 0)
+	Equal(t, vals[0].Interface(), "a")
+
+	_, vals, more = collectSeq(reflect.ValueOf(naturals), 3)
+	True(t, more)
+	Equal(t, len(vals), 3)
+}
+
+// naturals is an infinite seq
+func naturals(yield func(int) bool) {
+	for i := 0; yield(i); i++ {
+	}
+}
+
+func TestSeqsForEqual(t *testing.T) {
+	g, e, msg := seqsForEqual(slices.Values([]int{1}), []int{1})
+	Equal(t, msg, "")
+	Equal(t, g, []int{1})
+	Equal(t, e, []int{1})
+
+	g, _, _ = seqsForEqual(maps.All(map[string]int{"a": 1}), map[string]int{})
+	Equal(t, g, map[string]int{"a": 1})
+
+	g, _, _ = seqsForEqual(slices.All([]string{"a"}), []string{})
+	Equal(t, g, []seqPair{{0, "a"}})
+
+	_, _, msg = seqsForEqual(1, naturals)
+	NotEqual(t, msg, "")
+
+	dups := func(yield func(int, int) bool) {
+		_ = yield(1, 1) && yield(1, 2)
+	}
+	_, _, msg = seqsForEqual(dups, map[int]int{1: 2})
+	Contains(t, msg, "yielded the same key more than once")
+}
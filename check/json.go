@@ -0,0 +1,178 @@
+package check
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// parseJSON parses v into generic JSON values. Strings, []byte, and
+// json.RawMessage are parsed as-is; anything else is marshaled first.
+func parseJSON(v any) (any, error) {
+	var b []byte
+
+	switch v := v.(type) {
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	case json.RawMessage:
+		b = v
+	default:
+		var err error
+		b, err = json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var out any
+	err := dec.Decode(&out)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("invalid JSON: trailing data after value")
+	}
+
+	return out, nil
+}
+
+// jsonNumberEqual compares numbers by value, so that eg. 1 == 1.0 == 1e0
+func jsonNumberEqual(a, b json.Number) bool {
+	ar, aok := new(big.Rat).SetString(string(a))
+	br, bok := new(big.Rat).SetString(string(b))
+	if !aok || !bok {
+		return a == b
+	}
+
+	return ar.Cmp(br) == 0
+}
+
+// normalizeJSONNumbers rewrites every number in v in a canonical form, so that
+// numbers that are equal by value are also equal when marshaled
+func normalizeJSONNumbers(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		r, ok := new(big.Rat).SetString(string(v))
+		if !ok {
+			return v
+		}
+
+		if r.IsInt() {
+			return json.Number(r.Num().String())
+		}
+
+		// Numbers parsed from decimals always have a finite expansion
+		for prec := 1; ; prec++ {
+			s := r.FloatString(prec)
+			if f, _ := new(big.Rat).SetString(s); f.Cmp(r) == 0 {
+				return json.Number(s)
+			}
+		}
+
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			out[k] = normalizeJSONNumbers(e)
+		}
+
+		return out
+
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			out[i] = normalizeJSONNumbers(e)
+		}
+
+		return out
+
+	default:
+		return v
+	}
+}
+
+var jsonIdent = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// jsonPaths formats paths and values in diffs as JSON
+func jsonPaths() EqualOption {
+	return func(cfg *equalConfig) {
+		cfg.root = "$"
+		cfg.fmtKey = func(k reflect.Value) string {
+			key := k.String()
+			if jsonIdent.MatchString(key) {
+				return "." + key
+			}
+
+			return "[" + strconv.Quote(key) + "]"
+		}
+		cfg.fmtValue = func(v reflect.Value) string {
+			if !v.IsValid() {
+				return "undefined"
+			}
+
+			return marshalJSON(v.Interface(), "")
+		}
+	}
+}
+
+func marshalJSON(v any, indent string) string {
+	var b strings.Builder
+
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", indent)
+
+	err := enc.Encode(v)
+	if err != nil {
+		// Only generic values that were already parsed from JSON get here, so
+		// this can't happen
+		panic(err)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func checkJSONEq(g, e any) (string, bool) {
+	gv, err := parseJSON(g)
+	if err != nil {
+		return "Invalid JSON in got:\n" + dump(err, 1), false
+	}
+
+	ev, err := parseJSON(e)
+	if err != nil {
+		return "Invalid JSON in expected:\n" + dump(err, 1), false
+	}
+
+	opts := []EqualOption{
+		Comparer(jsonNumberEqual),
+		jsonPaths(),
+	}
+
+	if newEqualState(opts).equal(reflect.ValueOf(gv), reflect.ValueOf(ev)) {
+		return "", true
+	}
+
+	b := new(strings.Builder)
+	b.WriteString("Expected JSON to be equal:\n")
+
+	writePaths(b, pathDiffs(gv, ev, opts))
+
+	writeLineDiff(
+		b,
+		strings.Split(marshalJSON(normalizeJSONNumbers(gv), dumpIndent), "\n"),
+		strings.Split(marshalJSON(normalizeJSONNumbers(ev), dumpIndent), "\n"),
+	)
+
+	return b.String(), false
+}
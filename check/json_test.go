@@ -0,0 +1,88 @@
+package check
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseJSON(t *testing.T) {
+	for _, v := range []any{
+		`{"a": 1}`,
+		[]byte(`{"a": 1}`),
+		json.RawMessage(`{"a": 1}`),
+		map[string]int{"a": 1},
+	} {
+		got, err := parseJSON(v)
+		Nil(t, err)
+		Equal(t, got, map[string]any{"a": json.Number("1")})
+	}
+
+	_, err := parseJSON(`{"a": 1} 1`)
+	NotNil(t, err)
+
+	_, err = parseJSON(`{"a": `)
+	NotNil(t, err)
+
+	_, err = parseJSON(func() {})
+	NotNil(t, err)
+}
+
+func TestJSONNumberEqual(t *testing.T) {
+	True(t, jsonNumberEqual("1", "1.0"))
+	True(t, jsonNumberEqual("1e2", "100"))
+	True(t, jsonNumberEqual("0.1", "1e-1"))
+	False(t, jsonNumberEqual("1", "2"))
+	False(t, jsonNumberEqual("1", "nope"))
+}
+
+func TestCheckJSONEq(t *testing.T) {
+	testCheck(checkJSONEq(`{"a": 1, "b": [1, 2]}`, `{"b":[1,2],"a":1.0}`))(t, true)
+	testCheck(checkJSONEq(`{"a": 1}`, map[string]any{"a": 1}))(t, true)
+	testCheck(checkJSONEq(`{"a": 1}`, `{"a": 2}`))(t, false)
+	testCheck(checkJSONEq(`{"a": `, `{}`))(t, false)
+	testCheck(checkJSONEq(`{}`, `{"a": `))(t, false)
+
+	msg, ok := checkJSONEq(
+		`{"users": [{"zip": "1", "a b": true}], "extra": 1}`,
+		`{"users": [{"zip": "2", "a b": false}], "missing": null}`,
+	)
+	False(t, ok)
+	True(t, strings.HasPrefix(
+		msg,
+		"Expected JSON to be equal:\n"+
+			dumpIndent+"$.extra: unexpected key\n"+
+			dumpIndent+`$.users[0]["a b"]: true != false`+"\n"+
+			dumpIndent+`$.users[0].zip: "1" != "2"`+"\n"+
+			dumpIndent+"$.missing: missing key\n"+
+			"\n",
+	))
+	True(t, strings.Contains(msg, `-     "extra": 1,`))
+	True(t, strings.Contains(msg, `+     "missing": null,`))
+
+	msg, ok = checkJSONEq(`{"a": 1, "b": 0.50, "c": 1}`, `{"a": 1.0, "b": 5e-1, "c": 2}`)
+	False(t, ok)
+	True(t, strings.Contains(msg, `      "a": 1,`))
+	True(t, strings.Contains(msg, `      "b": 0.5,`))
+	False(t, strings.Contains(msg, `"a": 1.0`))
+}
+
+func TestNormalizeJSONNumbers(t *testing.T) {
+	Equal(
+		t,
+		normalizeJSONNumbers([]any{
+			json.Number("1.0"),
+			json.Number("1e2"),
+			json.Number("-0.250"),
+			json.Number("x"),
+			map[string]any{"a": json.Number("12.5e-1")},
+		}),
+		[]any{
+			json.Number("1"),
+			json.Number("100"),
+			json.Number("-0.25"),
+			json.Number("x"),
+			map[string]any{"a": json.Number("1.25")},
+		},
+	)
+}
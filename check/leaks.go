@@ -0,0 +1,257 @@
+package check
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thatguystone/cog/callstack"
+	"github.com/thatguystone/cog/textwrap"
+)
+
+// LeakTB is the subset of [testing.TB] that [NoGoroutineLeaks] needs.
+type LeakTB interface {
+	Error
+	Cleanup(func())
+}
+
+// A LeakOption customizes how [NoGoroutineLeaks] finds leaks.
+type LeakOption func(*leakConfig)
+
+type leakConfig struct {
+	ignore []string
+	poll   Poll
+}
+
+// IgnoreLeaks ignores goroutines that have any of the given functions in their
+// stacks. Functions are fully-qualified, eg. "net/http.(*persistConn).readLoop".
+func IgnoreLeaks(funcs ...string) LeakOption {
+	return func(cfg *leakConfig) {
+		cfg.ignore = append(cfg.ignore, funcs...)
+	}
+}
+
+// LeakPoll configures how long to wait for goroutines to exit before
+// reporting them as leaked. Zero fields of p keep their defaults, so there's
+// always a Timeout.
+func LeakPoll(p Poll) LeakOption {
+	return func(cfg *leakConfig) {
+		cfg.poll = p.or(cfg.poll)
+	}
+}
+
+// Goroutines that belong to the test framework and runtime
+var defaultLeakIgnores = []string{
+	"testing.tRunner",
+	"testing.(*T).Run",
+	"testing.runFuzzing",
+	"os/signal.signal_recv",
+	"os/signal.loop",
+}
+
+// NoGoroutineLeaks checks that every goroutine started after it's called has
+// exited by the time the test finishes. Since goroutines often take a moment to
+// exit, they're polled for a while before being reported. This isn't reliable
+// in parallel tests, which start goroutines of their own.
+func NoGoroutineLeaks(t LeakTB, opts ...LeakOption) {
+	cfg := leakConfig{
+		ignore: slices.Clone(defaultLeakIgnores),
+		poll: Poll{
+			Timeout:     time.Second,
+			Interval:    time.Millisecond,
+			Backoff:     2,
+			MaxInterval: 100 * time.Millisecond,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	before := make(map[int]struct{})
+	for _, g := range goroutines() {
+		before[g.id] = struct{}{}
+	}
+
+	t.Cleanup(func() {
+		if msg, ok := checkNoLeaks(before, cfg); !ok {
+			t.Helper()
+			t.Error("\n" + msg)
+		}
+	})
+}
+
+func checkNoLeaks(before map[int]struct{}, cfg leakConfig) (string, bool) {
+	var leaked []goroutine
+
+	res := cfg.poll.run(context.Background(), func(int) bool {
+		leaked = leaked[:0]
+		for _, g := range goroutines() {
+			_, existed := before[g.id]
+			if !existed && !g.calls(cfg.ignore) {
+				leaked = append(leaked, g)
+			}
+		}
+
+		return len(leaked) == 0
+	})
+	if res.err == nil {
+		return "", true
+	}
+
+	b := new(strings.Builder)
+	fmt.Fprintf(
+		b,
+		"Found %d leaked goroutines after waiting %s:",
+		len(leaked),
+		res.elapsed.Round(time.Millisecond),
+	)
+
+	for _, g := range leaked {
+		b.WriteString("\n\n")
+		b.WriteString(textwrap.Indent(g.String(), dumpIndent))
+	}
+
+	return b.String(), false
+}
+
+// A goroutine is parsed from a goroutine dump
+type goroutine struct {
+	id        int
+	state     string
+	frames    []callstack.Frame
+	createdBy callstack.Frame
+}
+
+// goroutines gets all running goroutines, except the calling one
+func goroutines() []goroutine {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+
+		buf = make([]byte, len(buf)*2)
+	}
+
+	gs := parseGoroutines(buf)
+	if len(gs) > 0 {
+		// The current goroutine always comes first
+		gs = gs[1:]
+	}
+
+	return gs
+}
+
+// parseGoroutines parses the output of [runtime.Stack]
+func parseGoroutines(dump []byte) (gs []goroutine) {
+	for block := range bytes.SplitSeq(bytes.TrimSpace(dump), []byte("\n\n")) {
+		if g, ok := parseGoroutine(string(block)); ok {
+			gs = append(gs, g)
+		}
+	}
+
+	return
+}
+
+// parseGoroutine parses a single goroutine, which looks like:
+//
+//	goroutine 7 [chan receive, 2 minutes]:
+//	pkg.fn(0x1, ...)
+//		/path/to/file.go:12 +0x1d
+//	created by pkg.parent in goroutine 1
+//		/path/to/file.go:5 +0x25
+func parseGoroutine(block string) (g goroutine, ok bool) {
+	lines := strings.Split(block, "\n")
+
+	header, found := strings.CutPrefix(lines[0], "goroutine ")
+	if !found {
+		return
+	}
+
+	id, state, found := strings.Cut(header, " [")
+	if !found {
+		return
+	}
+
+	g.id, _ = strconv.Atoi(id)
+	g.state, _, _ = strings.Cut(strings.TrimSuffix(state, "]:"), ",")
+
+	for i := 1; i < len(lines); i++ {
+		fn := lines[i]
+
+		var file string
+		if i+1 < len(lines) && strings.HasPrefix(lines[i+1], "\t") {
+			i++
+			file = lines[i]
+		}
+
+		// Skips "...additional frames elided..." and the like
+		if name, found := strings.CutPrefix(fn, "created by "); found {
+			name, _, _ = strings.Cut(name, " in goroutine ")
+			g.createdBy = parseFrame(name, file)
+		} else if strings.HasSuffix(fn, ")") {
+			g.frames = append(g.frames, parseFrame(fn, file))
+		}
+	}
+
+	ok = true
+	return
+}
+
+// parseFrame parses a function line, eg. "pkg.fn(0x1, ...)", and its file
+// line, eg. "\t/path/to/file.go:12 +0x1d".
+func parseFrame(fn, file string) callstack.Frame {
+	if i := strings.LastIndexByte(fn, '('); i > 0 && strings.HasSuffix(fn, ")") {
+		fn = fn[:i]
+	}
+
+	file = strings.TrimSpace(file)
+	file, _, _ = strings.Cut(file, " +0x")
+
+	var line int
+	if i := strings.LastIndexByte(file, ':'); i != -1 {
+		line, _ = strconv.Atoi(file[i+1:])
+		file = file[:i]
+	}
+
+	return callstack.NewFrame(runtime.Frame{
+		Function: fn,
+		File:     file,
+		Line:     line,
+	})
+}
+
+// calls checks if any of funcs are in g's stack
+func (g goroutine) calls(funcs []string) bool {
+	for _, frame := range g.frames {
+		if slices.Contains(funcs, frame.Func()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g goroutine) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "goroutine %d [%s]:\n", g.id, g.state)
+
+	for _, frame := range g.frames {
+		b.WriteString(frame.String())
+	}
+
+	if g.createdBy != (callstack.Frame{}) {
+		b.WriteString("created by ")
+		b.WriteString(g.createdBy.String())
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
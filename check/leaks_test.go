@@ -0,0 +1,145 @@
+package check
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type testLeakTB struct {
+	testTB
+	cleanups []func()
+}
+
+func (tb *testLeakTB) Cleanup(fn func()) {
+	tb.cleanups = append(tb.cleanups, fn)
+}
+
+func (tb *testLeakTB) runCleanups() {
+	for _, fn := range tb.cleanups {
+		fn()
+	}
+}
+
+var testLeakPoll = LeakPoll(Poll{Timeout: 50 * time.Millisecond})
+
+func leakyWorker(stop chan struct{}) {
+	<-stop
+}
+
+func TestNoGoroutineLeaks(t *testing.T) {
+	t.Run("Leak", func(t *testing.T) {
+		tb := new(testLeakTB)
+		NoGoroutineLeaks(tb, testLeakPoll)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go leakyWorker(stop)
+
+		tb.runCleanups()
+		Equal(t, len(tb.errors), 1)
+		Contains(t, tb.errors[0], "Found 1 leaked goroutines after waiting")
+		Contains(t, tb.errors[0], "[chan receive]:\n")
+		Contains(t, tb.errors[0], "check.leakyWorker()\n")
+		Contains(t, tb.errors[0], "created by github.com/thatguystone/cog/check.TestNoGoroutineLeaks.func1()\n")
+	})
+
+	t.Run("Exits", func(t *testing.T) {
+		tb := new(testLeakTB)
+		NoGoroutineLeaks(tb, testLeakPoll)
+
+		stop := make(chan struct{})
+		go leakyWorker(stop)
+		time.AfterFunc(10*time.Millisecond, func() { close(stop) })
+
+		tb.runCleanups()
+		Equal(t, len(tb.errors), 0)
+	})
+
+	t.Run("Ignore", func(t *testing.T) {
+		tb := new(testLeakTB)
+		NoGoroutineLeaks(
+			tb,
+			testLeakPoll,
+			IgnoreLeaks("github.com/thatguystone/cog/check.leakyWorker"),
+		)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go leakyWorker(stop)
+
+		tb.runCleanups()
+		Equal(t, len(tb.errors), 0)
+	})
+
+	t.Run("Existing", func(t *testing.T) {
+		stop := make(chan struct{})
+		defer close(stop)
+		go leakyWorker(stop)
+
+		tb := new(testLeakTB)
+		NoGoroutineLeaks(tb, testLeakPoll)
+		tb.runCleanups()
+		Equal(t, len(tb.errors), 0)
+	})
+}
+
+func TestLeakPoll(t *testing.T) {
+	cfg := leakConfig{
+		poll: Poll{Timeout: time.Second, Interval: time.Millisecond},
+	}
+
+	LeakPoll(Poll{Interval: 5 * time.Millisecond})(&cfg)
+	Equal(t, cfg.poll, Poll{Timeout: time.Second, Interval: 5 * time.Millisecond})
+}
+
+func TestParseGoroutines(t *testing.T) {
+	dump := strings.Join(
+		[]string{
+			"goroutine 1 [running]:",
+			"main.main()",
+			"\t/src/main.go:10 +0x1d",
+			"",
+			"goroutine 7 [chan receive, 2 minutes]:",
+			"example.com/pkg.(*T).wait(0xc000010000, {0x1, 0x2})",
+			"\t/src/pkg/t.go:12 +0x25",
+			"...additional frames elided...",
+			"created by example.com/pkg.Start in goroutine 1",
+			"\t/src/pkg/start.go:5 +0x3e",
+			"",
+			"bogus",
+		},
+		"\n",
+	)
+
+	gs := parseGoroutines([]byte(dump))
+	Equal(t, len(gs), 2)
+
+	Equal(t, gs[0].id, 1)
+	Equal(t, gs[0].state, "running")
+	Equal(t, len(gs[0].frames), 1)
+	Equal(t, gs[0].frames[0].Func(), "main.main")
+
+	g := gs[1]
+	Equal(t, g.id, 7)
+	Equal(t, g.state, "chan receive")
+	Equal(t, len(g.frames), 1)
+	Equal(t, g.frames[0].Func(), "example.com/pkg.(*T).wait")
+	Equal(t, g.frames[0].File(), "/src/pkg/t.go")
+	Equal(t, g.frames[0].Line(), 12)
+	Equal(t, g.createdBy.Func(), "example.com/pkg.Start")
+	Equal(t, g.createdBy.Line(), 5)
+
+	True(t, g.calls([]string{"example.com/pkg.(*T).wait"}))
+	False(t, g.calls([]string{"example.com/pkg.Start"}))
+
+	Equal(
+		t,
+		g.String(),
+		"goroutine 7 [chan receive]:\n"+
+			"example.com/pkg.(*T).wait()\n"+
+			"\t/src/pkg/t.go:12\n"+
+			"created by example.com/pkg.Start()\n"+
+			"\t/src/pkg/start.go:5",
+	)
+}
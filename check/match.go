@@ -0,0 +1,68 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// matchPattern treats the expected value as a pattern for [Match]
+func matchPattern() EqualOption {
+	return func(cfg *equalConfig) {
+		cfg.partial = true
+	}
+}
+
+func checkMatch(g, pattern any, opts ...EqualOption) (string, bool) {
+	opts = append([]EqualOption{matchPattern()}, opts...)
+
+	gv := reflect.ValueOf(g)
+	pv := reflect.ValueOf(pattern)
+	if newEqualState(opts).equal(gv, pv) {
+		return "", true
+	}
+
+	return matchMsg(diffValues(g, pattern, opts)), false
+}
+
+// matchMsg only shows the parts of the value that didn't match
+func matchMsg(diffs []valueDiff) string {
+	const maxDiffs = 16
+
+	b := new(strings.Builder)
+	b.WriteString("Expected value to match pattern:\n")
+
+	for i, vd := range diffs {
+		if i == maxDiffs {
+			fmt.Fprintf(b, "%s... %d more differences ...\n", dumpIndent, len(diffs)-maxDiffs)
+			break
+		}
+
+		switch {
+		case vd.path == "":
+			b.WriteString(dumpIndent + "got:\n")
+			b.WriteString(dumpValue(vd.a, 2) + "\n")
+			b.WriteString(dumpIndent + "pattern:\n")
+			b.WriteString(dumpValue(vd.b, 2) + "\n")
+
+		case vd.note != "":
+			b.WriteString(dumpIndent + vd.String() + "\n")
+
+		default:
+			gs := dumpValue(vd.a, 0)
+			ps := dumpValue(vd.b, 0)
+			if !strings.Contains(gs, "\n") && !strings.Contains(ps, "\n") {
+				b.WriteString(dumpIndent + vd.path + ": " + gs + " != " + ps + "\n")
+				continue
+			}
+
+			b.WriteString(dumpIndent + vd.path + ":\n")
+			b.WriteString(strings.Repeat(dumpIndent, 2) + "got:\n")
+			b.WriteString(dumpValue(vd.a, 3) + "\n")
+			b.WriteString(strings.Repeat(dumpIndent, 2) + "pattern:\n")
+			b.WriteString(dumpValue(vd.b, 3) + "\n")
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,294 @@
+// Package match provides composable [check.Matcher]s, for use with
+// [check.That].
+package match
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/thatguystone/cog/check"
+	"github.com/thatguystone/cog/check/internal/bipartite"
+	"github.com/thatguystone/cog/check/internal/failmsg"
+	"github.com/thatguystone/cog/textwrap"
+)
+
+const indent = failmsg.Indent
+
+// dump formats v for a failure message, indented one level
+func dump(v any) string {
+	return failmsg.Dump(v, 1)
+}
+
+// Eq matches values that are equal to e, as in [check.Equal].
+func Eq(e any) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		return failmsg.Equal(v, e)
+	})
+}
+
+// AllOf matches values that match every one of ms.
+func AllOf(ms ...check.Matcher) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		var msgs []string
+		for _, m := range ms {
+			if msg, ok := m.Match(v); !ok {
+				msgs = append(msgs, msg)
+			}
+		}
+
+		switch len(msgs) {
+		case 0:
+			return "", true
+		case 1:
+			return msgs[0], false
+		default:
+			return strings.Join(msgs, "\n\n"), false
+		}
+	})
+}
+
+// AnyOf matches values that match at least one of ms.
+func AnyOf(ms ...check.Matcher) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		var b strings.Builder
+		b.WriteString("Expected any of the following to match:")
+
+		for _, m := range ms {
+			msg, ok := m.Match(v)
+			if ok {
+				return "", true
+			}
+
+			b.WriteString("\n\n")
+			b.WriteString(textwrap.Indent(msg, indent))
+		}
+
+		return b.String(), false
+	})
+}
+
+// Not matches values that don't match m.
+func Not(m check.Matcher) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		if _, ok := m.Match(v); ok {
+			return "Expected value not to match:\n" + dump(v), false
+		}
+
+		return "", true
+	})
+}
+
+// HasLen matches arrays, slices, maps, strings, and channels of length n.
+func HasLen(n int) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		rv := reflect.ValueOf(v)
+
+		switch rv.Kind() {
+		case reflect.Array, reflect.Slice, reflect.Map, reflect.String, reflect.Chan:
+			if rv.Len() == n {
+				return "", true
+			}
+
+			msg := fmt.Sprintf("Expected length %d, got %d:\n", n, rv.Len())
+			return msg + dump(v), false
+
+		default:
+			return "Expected a value with a length, got:\n" + dump(v), false
+		}
+	})
+}
+
+// asString gets the string or []byte in v
+func asString(v any) (string, bool) {
+	rv := reflect.ValueOf(v)
+
+	switch {
+	case rv.Kind() == reflect.String:
+		return rv.String(), true
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		return string(rv.Bytes()), true
+	default:
+		return "", false
+	}
+}
+
+// MatchesRegexp matches strings and []byte that match the regular expression
+// expr. It panics if expr can't be compiled.
+func MatchesRegexp(expr string) check.Matcher {
+	re := regexp.MustCompile(expr)
+
+	return check.MatcherFunc(func(v any) (string, bool) {
+		s, ok := asString(v)
+		if !ok {
+			return "Expected a string, got:\n" + dump(v), false
+		}
+
+		if re.MatchString(s) {
+			return "", true
+		}
+
+		return "Expected value to match " + re.String() + ":\n" + dump(v), false
+	})
+}
+
+// HasPrefix matches strings and []byte that start with prefix.
+func HasPrefix(prefix string) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		s, ok := asString(v)
+		if !ok {
+			return "Expected a string, got:\n" + dump(v), false
+		}
+
+		if strings.HasPrefix(s, prefix) {
+			return "", true
+		}
+
+		return "Expected value to have prefix " + check.Dump(prefix) + ":\n" + dump(v), false
+	})
+}
+
+// Between matches values of type T in the inclusive range [lo, hi].
+func Between[T cmp.Ordered](lo, hi T) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		t, ok := v.(T)
+		if !ok {
+			msg := fmt.Sprintf(
+				"Expected a value of type %T between %s and %s, got:\n",
+				lo,
+				check.Dump(lo, check.DumpHideTypes()),
+				check.Dump(hi, check.DumpHideTypes()),
+			)
+			return msg + dump(v), false
+		}
+
+		if t >= lo && t <= hi {
+			return "", true
+		}
+
+		msg := "Expected value in [" + check.Dump(lo) + ", " + check.Dump(hi) + "], got:\n"
+		return msg + dump(v), false
+	})
+}
+
+// elements gets the elements of a slice or array
+func elements(v any) ([]any, bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	els := make([]any, rv.Len())
+	for i := range els {
+		els[i] = rv.Index(i).Interface()
+	}
+
+	return els, true
+}
+
+// ElementsAre matches slices and arrays whose elements match ms, in order.
+func ElementsAre(ms ...check.Matcher) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		els, ok := elements(v)
+		if !ok {
+			return "Expected a slice or array, got:\n" + dump(v), false
+		}
+
+		if len(els) != len(ms) {
+			msg := fmt.Sprintf("Expected %d elements, got %d:\n", len(ms), len(els))
+			return msg + dump(v), false
+		}
+
+		var msgs []string
+		for i, m := range ms {
+			if msg, ok := m.Match(els[i]); !ok {
+				msgs = append(
+					msgs,
+					fmt.Sprintf("Element [%d] does not match:\n", i)+
+						textwrap.Indent(msg, indent),
+				)
+			}
+		}
+
+		if len(msgs) == 0 {
+			return "", true
+		}
+
+		return strings.Join(msgs, "\n\n"), false
+	})
+}
+
+// UnorderedElementsAre matches slices and arrays whose elements match ms, in
+// any order. Each matcher must match a different element.
+func UnorderedElementsAre(ms ...check.Matcher) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		els, ok := elements(v)
+		if !ok {
+			return "Expected a slice or array, got:\n" + dump(v), false
+		}
+
+		if len(els) != len(ms) {
+			msg := fmt.Sprintf("Expected %d elements, got %d:\n", len(ms), len(els))
+			return msg + dump(v), false
+		}
+
+		matches := make([][]bool, len(els))
+		for i, el := range els {
+			matches[i] = make([]bool, len(ms))
+			for j, m := range ms {
+				_, matches[i][j] = m.Match(el)
+			}
+		}
+
+		elOf := bipartite.Match(matches)
+
+		var unmatched []int
+		for j, i := range elOf {
+			if i < 0 {
+				unmatched = append(unmatched, j)
+			}
+		}
+
+		if len(unmatched) == 0 {
+			return "", true
+		}
+
+		msg := fmt.Sprintf(
+			"Expected elements to match in any order, but matchers %v have no matching element:\n",
+			unmatched,
+		)
+		return msg + dump(v), false
+	})
+}
+
+// Field matches structs, or pointers to structs, whose field name matches m.
+func Field(name string, m check.Matcher) check.Matcher {
+	return check.MatcherFunc(func(v any) (string, bool) {
+		rv := reflect.ValueOf(v)
+		for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+			rv = rv.Elem()
+		}
+
+		if rv.Kind() != reflect.Struct {
+			return "Expected a struct, got:\n" + dump(v), false
+		}
+
+		f := rv.FieldByName(name)
+		if !f.IsValid() {
+			return fmt.Sprintf("Expected %s to have field %s", rv.Type(), name), false
+		}
+
+		if !f.CanInterface() {
+			return fmt.Sprintf("Field %s of %s is unexported", name, rv.Type()), false
+		}
+
+		msg, ok := m.Match(f.Interface())
+		if ok {
+			return "", true
+		}
+
+		return "Field " + name + " does not match:\n" + textwrap.Indent(msg, indent), false
+	})
+}
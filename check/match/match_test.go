@@ -0,0 +1,136 @@
+package match
+
+import (
+	"math"
+	"testing"
+
+	"github.com/thatguystone/cog/check"
+)
+
+func testMatch(t *testing.T, m check.Matcher, v any, expect bool) {
+	t.Helper()
+
+	msg, ok := m.Match(v)
+	if ok != expect {
+		t.Errorf("expected match=%t, got %t: %s", expect, ok, msg)
+	}
+
+	if !ok && msg == "" {
+		t.Error("expected a fail message, got nothing")
+	}
+}
+
+type testUser struct {
+	Name string
+	Tags []string
+	id   int
+}
+
+func TestEq(t *testing.T) {
+	testMatch(t, Eq(1), 1, true)
+	testMatch(t, Eq(1), 2, false)
+	testMatch(t, Eq([]int{1}), []int{1}, true)
+}
+
+func TestAllOf(t *testing.T) {
+	testMatch(t, AllOf(), 1, true)
+	testMatch(t, AllOf(Eq(1), Between(0, 2)), 1, true)
+	testMatch(t, AllOf(Eq(1), Between(2, 3)), 1, false)
+	testMatch(t, AllOf(Eq(2), Between(2, 3)), 1, false)
+}
+
+func TestAnyOf(t *testing.T) {
+	testMatch(t, AnyOf(), 1, false)
+	testMatch(t, AnyOf(Eq(2), Eq(1)), 1, true)
+	testMatch(t, AnyOf(Eq(2), Eq(3)), 1, false)
+}
+
+func TestNot(t *testing.T) {
+	testMatch(t, Not(Eq(1)), 2, true)
+	testMatch(t, Not(Eq(1)), 1, false)
+}
+
+func TestHasLen(t *testing.T) {
+	testMatch(t, HasLen(2), []int{1, 2}, true)
+	testMatch(t, HasLen(2), [2]int{}, true)
+	testMatch(t, HasLen(1), map[int]int{1: 1}, true)
+	testMatch(t, HasLen(3), "abc", true)
+	testMatch(t, HasLen(0), make(chan int), true)
+	testMatch(t, HasLen(1), []int{}, false)
+	testMatch(t, HasLen(1), 1, false)
+}
+
+func TestMatchesRegexp(t *testing.T) {
+	testMatch(t, MatchesRegexp(`^a+$`), "aaa", true)
+	testMatch(t, MatchesRegexp(`^a+$`), []byte("aaa"), true)
+	testMatch(t, MatchesRegexp(`^a+$`), "aab", false)
+	testMatch(t, MatchesRegexp(`^a+$`), 1, false)
+
+	check.Panics(t, func() { MatchesRegexp(`(`) })
+}
+
+func TestHasPrefix(t *testing.T) {
+	testMatch(t, HasPrefix("ab"), "abc", true)
+	testMatch(t, HasPrefix("ab"), []byte("abc"), true)
+	testMatch(t, HasPrefix("ab"), "bc", false)
+	testMatch(t, HasPrefix("ab"), 1, false)
+}
+
+func TestBetween(t *testing.T) {
+	testMatch(t, Between(1, 3), 1, true)
+	testMatch(t, Between(1, 3), 3, true)
+	testMatch(t, Between(1, 3), 4, false)
+	testMatch(t, Between(1, 3), 0, false)
+	testMatch(t, Between(1, 3), int64(2), false)
+	testMatch(t, Between("a", "c"), "b", true)
+	testMatch(t, Between(0, 1.0), math.NaN(), false)
+	testMatch(t, Between(math.NaN(), 1), 0.5, false)
+
+	msg, _ := Between(1, 3).Match(int64(2))
+	check.HasPrefix(t, msg, "Expected a value of type int between 1 and 3, got:\n")
+}
+
+func TestElementsAre(t *testing.T) {
+	testMatch(t, ElementsAre(), []int{}, true)
+	testMatch(t, ElementsAre(Eq(1), Between(2, 3)), []int{1, 3}, true)
+	testMatch(t, ElementsAre(Eq(1), Between(2, 3)), [2]int{1, 3}, true)
+	testMatch(t, ElementsAre(Eq(1), Between(2, 3)), []int{3, 1}, false)
+	testMatch(t, ElementsAre(Eq(1)), []int{1, 2}, false)
+	testMatch(t, ElementsAre(Eq(1)), 1, false)
+
+	msg, _ := ElementsAre(Eq(1), Eq(2)).Match([]int{1, 3})
+	check.Contains(t, msg, "Element [1] does not match:\n")
+}
+
+func TestUnorderedElementsAre(t *testing.T) {
+	testMatch(t, UnorderedElementsAre(), []int{}, true)
+	testMatch(t, UnorderedElementsAre(Eq(1), Eq(2)), []int{2, 1}, true)
+	testMatch(t, UnorderedElementsAre(Eq(1), Eq(2)), []int{2, 2}, false)
+	testMatch(t, UnorderedElementsAre(Eq(1)), []int{1, 2}, false)
+	testMatch(t, UnorderedElementsAre(Eq(1)), "1", false)
+
+	// A greedy assignment would give the first element to Between(1, 2), and
+	// then have nothing left for Eq(2)
+	testMatch(
+		t,
+		UnorderedElementsAre(Between(1, 2), Eq(2)),
+		[]int{2, 1},
+		true,
+	)
+
+	msg, _ := UnorderedElementsAre(Eq(1), Eq(3)).Match([]int{1, 2})
+	check.Contains(t, msg, "matchers [1] have no matching element")
+}
+
+func TestField(t *testing.T) {
+	u := testUser{Name: "bob", Tags: []string{"a"}, id: 1}
+
+	testMatch(t, Field("Name", Eq("bob")), u, true)
+	testMatch(t, Field("Name", Eq("bob")), &u, true)
+	testMatch(t, Field("Tags", ElementsAre(Eq("a"))), u, true)
+	testMatch(t, Field("Name", Eq("alice")), u, false)
+	testMatch(t, Field("Missing", Eq("bob")), u, false)
+	testMatch(t, Field("id", Eq(1)), u, false)
+	testMatch(t, Field("Name", Eq("bob")), 1, false)
+	testMatch(t, Field("Name", Eq("bob")), (*testUser)(nil), false)
+}
@@ -0,0 +1,81 @@
+package check
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckMatch(t *testing.T) {
+	u := testUser{
+		Name:    "bob",
+		Created: time.Now(),
+		Address: testAddress{Street: "main", Zip: "12345"},
+		Tags:    []string{"a", "b"},
+		id:      1,
+	}
+
+	t.Run("Basic", func(t *testing.T) {
+		testCheck(checkMatch(u, testUser{}))(t, true)
+		testCheck(checkMatch(u, testUser{Name: "bob"}))(t, true)
+		testCheck(checkMatch(u, testUser{Name: "alice"}))(t, false)
+		testCheck(checkMatch(u, testUser{Address: testAddress{Zip: "12345"}}))(t, true)
+		testCheck(checkMatch(u, testUser{Tags: []string{"", "b"}}))(t, true)
+		testCheck(checkMatch(u, testUser{Tags: []string{"b"}}))(t, false)
+		testCheck(checkMatch(u, &testUser{Name: "bob"}))(t, false)
+		testCheck(checkMatch(&u, &testUser{Name: "bob"}))(t, true)
+		testCheck(checkMatch(1, "1"))(t, false)
+	})
+
+	t.Run("IgnoreFields", func(t *testing.T) {
+		p := testUser{Name: "bob", Address: testAddress{Zip: "0"}}
+		testCheck(checkMatch(u, p))(t, false)
+		testCheck(checkMatch(u, p, IgnoreFields("Address.Zip")))(t, true)
+	})
+
+	t.Run("Maps", func(t *testing.T) {
+		m := map[string]any{
+			"a": 1,
+			"b": map[string]any{"c": 2, "d": 3},
+		}
+
+		testCheck(checkMatch(m, map[string]any{}))(t, true)
+		testCheck(checkMatch(m, map[string]any{"a": 1}))(t, true)
+		testCheck(checkMatch(m, map[string]any{"b": map[string]any{"d": 3}}))(t, true)
+		testCheck(checkMatch(m, map[string]any{"b": map[string]any{"d": 4}}))(t, false)
+		testCheck(checkMatch(m, map[string]any{"e": 1}))(t, false)
+	})
+
+	t.Run("Message", func(t *testing.T) {
+		msg, ok := checkMatch(
+			u,
+			testUser{
+				Name:    "alice",
+				Address: testAddress{Zip: "0"},
+				Tags:    []string{"a"},
+			},
+		)
+		False(t, ok)
+		Equal(
+			t,
+			msg,
+			"Expected value to match pattern:\n"+
+				dumpIndent+`.Name: "bob" != "alice"`+"\n"+
+				dumpIndent+`.Address.Zip: "12345" != "0"`+"\n"+
+				dumpIndent+".Tags: len 2 != 1",
+		)
+
+		msg, ok = checkMatch(map[string]int{"a": 1}, map[string]int{"b": 1})
+		False(t, ok)
+		Equal(
+			t,
+			msg,
+			"Expected value to match pattern:\n"+
+				dumpIndent+`["b"]: missing key`,
+		)
+
+		msg, ok = checkMatch(1, "1")
+		False(t, ok)
+		True(t, strings.HasPrefix(msg, "Expected value to match pattern:\n"+dumpIndent+"got:\n"))
+	})
+}
@@ -0,0 +1,28 @@
+package check
+
+import "github.com/thatguystone/cog/check/internal/failmsg"
+
+// A Matcher checks if a value matches some condition, returning a failure
+// message if it doesn't. Package github.com/thatguystone/cog/check/match
+// provides matchers and ways to compose them.
+type Matcher interface {
+	Match(v any) (msg string, ok bool)
+}
+
+// MatcherFunc adapts a func to a [Matcher].
+type MatcherFunc func(v any) (msg string, ok bool)
+
+// Match implements [Matcher].
+func (f MatcherFunc) Match(v any) (string, bool) {
+	return f(v)
+}
+
+// Package match builds its messages the same way
+func init() {
+	failmsg.Dump = dump
+	failmsg.Equal = checkEqual
+}
+
+func checkThat(v any, m Matcher) (string, bool) {
+	return m.Match(v)
+}
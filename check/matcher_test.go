@@ -0,0 +1,16 @@
+package check
+
+import "testing"
+
+func TestCheckThat(t *testing.T) {
+	even := MatcherFunc(func(v any) (string, bool) {
+		if v.(int)%2 == 0 {
+			return "", true
+		}
+
+		return "Expected an even number, got:\n" + dump(v, 1), false
+	})
+
+	testCheck(checkThat(2, even))(t, true)
+	testCheck(checkThat(1, even))(t, false)
+}
@@ -0,0 +1,103 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/thatguystone/cog/callstack"
+	"github.com/thatguystone/cog/textwrap"
+)
+
+func checkRecover(fn func()) (value any, stack callstack.Stack, msg string, ok bool) {
+	value, stack, ok = recoverPanic(fn)
+	if !ok {
+		msg = "Expected func to panic"
+	}
+
+	return
+}
+
+// recoverPanic calls fn, recovering from any panic it causes. The returned
+// stack starts where the panic happened.
+func recoverPanic(fn func()) (r any, stack callstack.Stack, panicked bool) {
+	defer func() {
+		r = recover()
+		if !panicked {
+			return
+		}
+
+		stack = callstack.GetSkip(1)
+
+		// Drop gopanic and friends
+		for i, pc := range stack {
+			f := runtime.FuncForPC(pc - 1)
+			if f == nil || !strings.HasPrefix(f.Name(), "runtime.") {
+				stack = stack[i:]
+				break
+			}
+		}
+	}()
+
+	// If fn panics, this never gets set to false
+	panicked = true
+	fn()
+	panicked = false
+
+	return
+}
+
+func panicStack(stack callstack.Stack) string {
+	return textwrap.Indent(stack.String(), dumpIndent)
+}
+
+func checkPanicsWithError(target error, fn func()) (string, bool) {
+	r, stack, panicked := recoverPanic(fn)
+	if !panicked {
+		return "Expected func to panic", false
+	}
+
+	err, isErr := r.(error)
+	if !isErr {
+		msg := "Expected func to panic with an error, got:\n" +
+			dump(r, 1) +
+			"\n" +
+			"\n" +
+			panicStack(stack)
+		return msg, false
+	}
+
+	if errors.Is(err, target) {
+		return "", true
+	}
+
+	msg := errorMsg("Expected panic error tree to contain target", "target", errorTree(target), err) +
+		"\n" +
+		"\n" +
+		panicStack(stack)
+	return msg, false
+}
+
+func checkPanicsMatching(re any, fn func()) (string, bool) {
+	rx, msg := toRegexp(re)
+	if msg != "" {
+		return msg, false
+	}
+
+	r, stack, panicked := recoverPanic(fn)
+	if !panicked {
+		return "Expected func to panic", false
+	}
+
+	s := fmt.Sprint(r)
+	if rx.MatchString(s) {
+		return "", true
+	}
+
+	msg = stringMsg("Expected panic value to match regexp", "regexp", rx.String(), s) +
+		"\n" +
+		"\n" +
+		panicStack(stack)
+	return msg, false
+}
@@ -0,0 +1,79 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"testing"
+)
+
+func panicsHere(v any) {
+	panic(v)
+}
+
+func TestRecoverPanic(t *testing.T) {
+	r, stack, panicked := recoverPanic(func() { panicsHere("oops") })
+	True(t, panicked)
+	Equal(t, r, "oops")
+
+	for frame := range stack.Frames() {
+		Equal(t, frame.FuncName(), "panicsHere")
+		break
+	}
+
+	r, stack, panicked = recoverPanic(func() {})
+	False(t, panicked)
+	Nil(t, r)
+	Equal(t, len(stack), 0)
+
+	// Go 1.21+ converts panic(nil) into a *runtime.PanicNilError
+	_, _, panicked = recoverPanic(func() { panic(nil) })
+	True(t, panicked)
+}
+
+func TestRecover(t *testing.T) {
+	tb := new(testTB)
+
+	r, stack := Recover(tb, func() { panicsHere(1) })
+	Equal(t, r, 1)
+	NotEqual(t, len(stack), 0)
+	Equal(t, len(tb.errors), 0)
+
+	r, stack = Recover(tb, func() {})
+	Nil(t, r)
+	Equal(t, len(stack), 0)
+	Equal(t, len(tb.errors), 1)
+
+	r, _ = MustRecover(tb, func() { panicsHere(2) })
+	Equal(t, r, 2)
+	Equal(t, len(tb.fatals), 0)
+
+	MustRecover(tb, func() {})
+	Equal(t, len(tb.fatals), 1)
+}
+
+func TestCheckPanicsWithError(t *testing.T) {
+	wrapped := fmt.Errorf("wrapped: %w", io.EOF)
+
+	testCheck(checkPanicsWithError(io.EOF, func() { panic(wrapped) }))(t, true)
+	testCheck(checkPanicsWithError(io.ErrClosedPipe, func() { panic(wrapped) }))(t, false)
+	testCheck(checkPanicsWithError(io.EOF, func() { panic("EOF") }))(t, false)
+	testCheck(checkPanicsWithError(io.EOF, func() {}))(t, false)
+
+	msg, _ := checkPanicsWithError(io.ErrClosedPipe, func() { panicsHere(wrapped) })
+	Contains(t, msg, "Expected panic error tree to contain target:\n")
+	Contains(t, msg, "check.panicsHere()")
+}
+
+func TestCheckPanicsMatching(t *testing.T) {
+	testCheck(checkPanicsMatching(`^bad \d+$`, func() { panic("bad 1") }))(t, true)
+	testCheck(checkPanicsMatching(regexp.MustCompile(`EOF`), func() { panic(io.EOF) }))(t, true)
+	testCheck(checkPanicsMatching(`^bad`, func() { panic(errors.New("good")) }))(t, false)
+	testCheck(checkPanicsMatching(`^bad`, func() {}))(t, false)
+	testCheck(checkPanicsMatching(`(`, func() { panic("bad") }))(t, false)
+
+	msg, _ := checkPanicsMatching(`^bad`, func() { panicsHere("good") })
+	Contains(t, msg, "Expected panic value to match regexp:\n")
+	Contains(t, msg, "check.panicsHere()")
+}
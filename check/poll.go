@@ -0,0 +1,145 @@
+package check
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Poll configures how the time-based Eventually checks poll. The zero Poll
+// tries every 10ms until the context is done, so it never gives up if the
+// context has no deadline.
+type Poll struct {
+	// Max amount of time to poll for. If 0, polling continues until the
+	// context is done.
+	Timeout time.Duration
+
+	// Delay between attempts. If 0, defaults to 10ms.
+	Interval time.Duration
+
+	// If > 1, Interval is multiplied by this after every attempt.
+	Backoff float64
+
+	// Caps Interval when backing off. If 0, there is no cap.
+	MaxInterval time.Duration
+
+	// Fraction, in [0, 1], of each delay that is randomized.
+	Jitter float64
+}
+
+const defaultPollInterval = 10 * time.Millisecond
+
+type pollResult struct {
+	tries   int
+	elapsed time.Duration
+	err     error // Why polling stopped, nil if it succeeded
+}
+
+func (p Poll) run(ctx context.Context, fn func(i int) bool) (res pollResult) {
+	start := time.Now()
+	defer func() {
+		res.elapsed = time.Since(start)
+	}()
+
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	interval := p.Interval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for i := 0; ; i++ {
+		res.tries++
+		if fn(i) {
+			return
+		}
+
+		timer.Reset(p.jitter(interval))
+
+		select {
+		case <-ctx.Done():
+			res.err = ctx.Err()
+			return
+		case <-timer.C:
+		}
+
+		interval = p.backoff(interval)
+	}
+}
+
+// or fills in any zero fields of p from def.
+func (p Poll) or(def Poll) Poll {
+	p.Timeout = cmp.Or(p.Timeout, def.Timeout)
+	p.Interval = cmp.Or(p.Interval, def.Interval)
+	p.Backoff = cmp.Or(p.Backoff, def.Backoff)
+	p.MaxInterval = cmp.Or(p.MaxInterval, def.MaxInterval)
+	p.Jitter = cmp.Or(p.Jitter, def.Jitter)
+	return p
+}
+
+func (p Poll) backoff(d time.Duration) time.Duration {
+	if p.Backoff > 1 {
+		d = time.Duration(float64(d) * p.Backoff)
+	}
+
+	if p.MaxInterval > 0 {
+		d = min(d, p.MaxInterval)
+	}
+
+	return d
+}
+
+func (p Poll) jitter(d time.Duration) time.Duration {
+	if p.Jitter <= 0 {
+		return d
+	}
+
+	j := min(p.Jitter, 1) * float64(d)
+	return d + time.Duration((rand.Float64()*2-1)*j)
+}
+
+func (res pollResult) String() string {
+	return fmt.Sprintf(
+		"%s (%d tries): %v",
+		res.elapsed.Round(time.Millisecond),
+		res.tries,
+		res.err,
+	)
+}
+
+func checkEventuallyTrueWithin(ctx context.Context, p Poll, fn func(i int) bool) (string, bool) {
+	res := p.run(ctx, fn)
+	if res.err == nil {
+		return "", true
+	}
+
+	return "Polling for condition failed, gave up after " + res.String(), false
+}
+
+func checkEventuallyNilWithin(ctx context.Context, p Poll, fn func(i int) error) (string, bool) {
+	var err error
+
+	res := p.run(ctx, func(i int) bool {
+		err = fn(i)
+		return err == nil
+	})
+	if res.err == nil {
+		return "", true
+	}
+
+	msg := fmt.Sprintf(
+		"Func didn't succeed after %s, last err:\n%s",
+		res,
+		dump(err, 1),
+	)
+	return msg, false
+}
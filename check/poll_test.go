@@ -0,0 +1,63 @@
+package check
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestPollBackoff(t *testing.T) {
+	p := Poll{
+		Backoff:     2,
+		MaxInterval: 3 * time.Second,
+	}
+
+	Equal(t, p.backoff(time.Second), 2*time.Second)
+	Equal(t, p.backoff(2*time.Second), 3*time.Second)
+	Equal(t, Poll{}.backoff(time.Second), time.Second)
+}
+
+func TestPollJitter(t *testing.T) {
+	p := Poll{Jitter: 0.5}
+
+	for range 100 {
+		d := p.jitter(time.Second)
+		True(t, d >= time.Second/2 && d <= 3*time.Second/2)
+	}
+
+	Equal(t, Poll{}.jitter(time.Second), time.Second)
+}
+
+func TestCheckEventuallyTrueWithin(t *testing.T) {
+	p := Poll{
+		Timeout:  50 * time.Millisecond,
+		Interval: time.Millisecond,
+		Backoff:  1.5,
+		Jitter:   0.1,
+	}
+
+	testCheck(checkEventuallyTrueWithin(t.Context(), p, func(i int) bool { return i == 3 }))(t, true)
+	testCheck(checkEventuallyTrueWithin(t.Context(), p, func(i int) bool { return false }))(t, false)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	msg, ok := checkEventuallyTrueWithin(ctx, Poll{}, func(i int) bool { return false })
+	False(t, ok)
+	Contains(t, msg, "(1 tries): context canceled")
+}
+
+func TestCheckEventuallyNilWithin(t *testing.T) {
+	p := Poll{
+		Timeout:  20 * time.Millisecond,
+		Interval: time.Millisecond,
+	}
+
+	testCheck(checkEventuallyNilWithin(t.Context(), p, func(i int) error { return nil }))(t, true)
+
+	msg, ok := checkEventuallyNilWithin(t.Context(), p, func(i int) error { return os.ErrClosed })
+	False(t, ok)
+	Contains(t, msg, "context deadline exceeded")
+	Contains(t, msg, os.ErrClosed.Error())
+}
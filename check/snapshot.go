@@ -0,0 +1,197 @@
+package check
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/thatguystone/cog/callstack"
+)
+
+func snapshotString(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+
+	return Dump(v)
+}
+
+func checkSnapshot(pc callstack.PC, got any, expect string) (string, bool) {
+	gs := snapshotString(got)
+	if gs == expect {
+		return "", true
+	}
+
+	if Updating() {
+		frame := pc.Frame()
+
+		err := snapshots.rewrite(frame.File(), frame.Line(), gs)
+		if err != nil {
+			return "Failed to update snapshot:\n" + dump(err, 1), false
+		}
+
+		return "", true
+	}
+
+	b := new(strings.Builder)
+	b.WriteString("Snapshot does not match, run with -check.update to update it:\n")
+	writeLineDiff(b, strings.Split(gs, "\n"), strings.Split(expect, "\n"))
+
+	return b.String(), false
+}
+
+// snapshotFiles tracks source files that are being rewritten. Since the line
+// numbers that callers report are from the original source, every file is
+// parsed once, and all rewrites are applied to its original contents.
+type snapshotFiles struct {
+	mtx   sync.Mutex
+	files map[string]*snapshotFile
+}
+
+type snapshotFile struct {
+	src   []byte
+	fset  *token.FileSet
+	ast   *ast.File
+	edits map[int]snapshotEdit // By start offset of the literal being replaced
+}
+
+type snapshotEdit struct {
+	start, end int
+	lit        string
+}
+
+var snapshots = snapshotFiles{
+	files: make(map[string]*snapshotFile),
+}
+
+func (sfs *snapshotFiles) rewrite(path string, line int, v string) error {
+	sfs.mtx.Lock()
+	defer sfs.mtx.Unlock()
+
+	sf, ok := sfs.files[path]
+	if !ok {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+
+		sf = &snapshotFile{
+			src:   src,
+			fset:  fset,
+			ast:   f,
+			edits: make(map[int]snapshotEdit),
+		}
+		sfs.files[path] = sf
+	}
+
+	lit, err := sf.findLit(line)
+	if err != nil {
+		return fmt.Errorf("%s:%d: %w", path, line, err)
+	}
+
+	start := sf.fset.Position(lit.Pos()).Offset
+	sf.edits[start] = snapshotEdit{
+		start: start,
+		end:   sf.fset.Position(lit.End()).Offset,
+		lit:   snapshotLit(v),
+	}
+
+	return os.WriteFile(path, sf.apply(), 0o640)
+}
+
+// findLit finds the expect literal of the innermost snapshot call that
+// includes the given line.
+func (sf *snapshotFile) findLit(line int) (*ast.BasicLit, error) {
+	var call *ast.CallExpr
+
+	ast.Inspect(sf.ast, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+
+		start := sf.fset.Position(n.Pos()).Line
+		end := sf.fset.Position(n.End()).Line
+		if line < start || line > end {
+			return false
+		}
+
+		if c, ok := n.(*ast.CallExpr); ok && isSnapshotCall(c) {
+			call = c
+		}
+
+		return true
+	})
+
+	if call == nil {
+		return nil, errors.New("no snapshot call found")
+	}
+
+	lit, ok := call.Args[len(call.Args)-1].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil, errors.New("snapshot expect value must be a string literal")
+	}
+
+	return lit, nil
+}
+
+func isSnapshotCall(c *ast.CallExpr) bool {
+	var name string
+
+	switch fn := c.Fun.(type) {
+	case *ast.Ident:
+		name = fn.Name
+	case *ast.SelectorExpr:
+		name = fn.Sel.Name
+	}
+
+	return (name == "Snapshot" || name == "MustSnapshot") && len(c.Args) > 0
+}
+
+func (sf *snapshotFile) apply() []byte {
+	edits := make([]snapshotEdit, 0, len(sf.edits))
+	for _, edit := range sf.edits {
+		edits = append(edits, edit)
+	}
+
+	slices.SortFunc(edits, func(a, b snapshotEdit) int {
+		return cmp.Compare(a.start, b.start)
+	})
+
+	var (
+		b    bytes.Buffer
+		prev = 0
+	)
+
+	for _, edit := range edits {
+		b.Write(sf.src[prev:edit.start])
+		b.WriteString(edit.lit)
+		prev = edit.end
+	}
+
+	b.Write(sf.src[prev:])
+	return b.Bytes()
+}
+
+func snapshotLit(v string) string {
+	preferRaw := strings.ContainsAny(v, "\n\"")
+	if preferRaw && strconv.CanBackquote(strings.ReplaceAll(v, "\n", "")) {
+		return "`" + v + "`"
+	}
+
+	return strconv.Quote(v)
+}
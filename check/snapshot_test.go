@@ -0,0 +1,81 @@
+package check
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thatguystone/cog/callstack"
+	"github.com/thatguystone/cog/textwrap"
+)
+
+func TestSnapshotLit(t *testing.T) {
+	Equal(t, snapshotLit("plain"), `"plain"`)
+	Equal(t, snapshotLit(`"quotes"`), "`\"quotes\"`")
+	Equal(t, snapshotLit("a\nb"), "`a\nb`")
+	Equal(t, snapshotLit("a\n`b`"), `"a\n`+"`b`"+`"`)
+	Equal(t, snapshotLit("a\r\nb"), `"a\r\nb"`)
+}
+
+func TestCheckSnapshot(t *testing.T) {
+	pc := callstack.Self()
+
+	testCheck(checkSnapshot(pc, "test", "test"))(t, true)
+	testCheck(checkSnapshot(pc, 1, "int(1)"))(t, true)
+
+	msg, ok := checkSnapshot(pc, "a\nb", "a\nc")
+	False(t, ok)
+	Contains(t, msg, "- b")
+	Contains(t, msg, "+ c")
+
+	t.Setenv("CHECK_UPDATE", "1")
+
+	msg, ok = checkSnapshot(callstack.PC{}, "test", "nope")
+	False(t, ok)
+	Contains(t, msg, "Failed to update snapshot")
+}
+
+func TestSnapshotRewrite(t *testing.T) {
+	src := textwrap.Dedent(`
+		package x
+
+		func TestX(t *testing.T) {
+			check.Snapshot(t, got, "old")
+			check.New(t).Must().Snapshot(
+				got,
+				"old2",
+			)
+			check.Snapshot(t, got, expect)
+			check.Equal(t, got, "old")
+		}
+	`)
+
+	path := filepath.Join(t.TempDir(), "x_test.go")
+	err := os.WriteFile(path, []byte(src), 0o640)
+	MustNil(t, err)
+
+	sfs := snapshotFiles{
+		files: make(map[string]*snapshotFile),
+	}
+
+	MustNil(t, sfs.rewrite(path, 5, "a\nb"))
+	MustNil(t, sfs.rewrite(path, 9, "new"))
+	MustNil(t, sfs.rewrite(path, 5, "a\nb\nc"))
+	NotNil(t, sfs.rewrite(path, 10, "new"))
+	NotNil(t, sfs.rewrite(path, 11, "new"))
+	NotNil(t, sfs.rewrite(filepath.Join(path, "nope"), 1, ""))
+
+	b, err := os.ReadFile(path)
+	MustNil(t, err)
+	expect := strings.Replace(src, `"old")`, "`a\nb\nc`)", 1)
+	expect = strings.Replace(expect, `"old2"`, `"new"`, 1)
+	Equal(t, string(b), expect)
+}
+
+func TestSnapshot(t *testing.T) {
+	Snapshot(t, []int{1}, "[]int{\n    int(1),\n}")
+	MustSnapshot(t, "test", "test")
+	New(t).Snapshot("test", "test")
+	New(t).Must().Snapshot("test", "test")
+}
@@ -0,0 +1,111 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// stringMsg describes a failed check of str against what
+func stringMsg(title, what string, v any, str string) string {
+	return title + ":\n" +
+		dumpIndent + upperFirst(what) + ":\n" +
+		dump(v, 2) +
+		"\n" +
+		dumpIndent + "String:\n" +
+		dump(str, 2)
+}
+
+// toRegexp gets re as a [*regexp.Regexp]; it may be one already, or a string
+// to compile
+func toRegexp(re any) (*regexp.Regexp, string) {
+	switch re := re.(type) {
+	case *regexp.Regexp:
+		return re, ""
+
+	case string:
+		compiled, err := regexp.Compile(re)
+		if err != nil {
+			return nil, "Invalid regexp:\n" + dump(err, 1)
+		}
+
+		return compiled, ""
+
+	default:
+		return nil, fmt.Sprintf("Cannot use %T as a regexp", re)
+	}
+}
+
+func checkMatches(s string, re any) (string, bool) {
+	r, msg := toRegexp(re)
+	if msg != "" {
+		return msg, false
+	}
+
+	if r.MatchString(s) {
+		return "", true
+	}
+
+	return stringMsg("Expected string to match regexp", "regexp", r.String(), s), false
+}
+
+func checkNotMatches(s string, re any) (string, bool) {
+	r, msg := toRegexp(re)
+	if msg != "" {
+		return msg, false
+	}
+
+	loc := r.FindStringIndex(s)
+	if loc == nil {
+		return "", true
+	}
+
+	msg = stringMsg("Unexpectedly matched regexp", "regexp", r.String(), s) +
+		"\n" +
+		dumpIndent + "Match:\n" +
+		dump(s[loc[0]:loc[1]], 2)
+	return msg, false
+}
+
+func checkHasPrefix(s, prefix string) (string, bool) {
+	if strings.HasPrefix(s, prefix) {
+		return "", true
+	}
+
+	return stringMsg("Expected string to have prefix", "prefix", prefix, s), false
+}
+
+func checkHasSuffix(s, suffix string) (string, bool) {
+	if strings.HasSuffix(s, suffix) {
+		return "", true
+	}
+
+	return stringMsg("Expected string to have suffix", "suffix", suffix, s), false
+}
+
+func checkEqualFold(g, e string) (string, bool) {
+	if strings.EqualFold(g, e) {
+		return "", true
+	}
+
+	title := "Expected strings to be equal, ignoring case"
+	return stringMsg(title, "expected", e, g), false
+}
+
+func checkContainsInOrder(s string, substrs ...string) (string, bool) {
+	rest := s
+	for _, sub := range substrs {
+		i := strings.Index(rest, sub)
+		if i == -1 {
+			title := fmt.Sprintf(
+				"Expected to find substring in string after byte %d",
+				len(s)-len(rest),
+			)
+			return stringMsg(title, "substring", sub, s), false
+		}
+
+		rest = rest[i+len(sub):]
+	}
+
+	return "", true
+}
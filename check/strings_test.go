@@ -0,0 +1,64 @@
+package check
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestCheckMatches(t *testing.T) {
+	testCheck(checkMatches("abc", `^a`))(t, true)
+	testCheck(checkMatches("abc", regexp.MustCompile(`c$`)))(t, true)
+	testCheck(checkMatches("abc", `^b`))(t, false)
+	testCheck(checkMatches("abc", `(`))(t, false)
+	testCheck(checkMatches("abc", 1))(t, false)
+
+	msg, _ := checkMatches("abc", `^b`)
+	Equal(
+		t,
+		msg,
+		"Expected string to match regexp:\n"+
+			dumpIndent+"Regexp:\n"+
+			dumpIndent+dumpIndent+`"^b"`+"\n"+
+			dumpIndent+"String:\n"+
+			dumpIndent+dumpIndent+`"abc"`,
+	)
+}
+
+func TestCheckNotMatches(t *testing.T) {
+	testCheck(checkNotMatches("abc", `^b`))(t, true)
+	testCheck(checkNotMatches("abc", `b+`))(t, false)
+	testCheck(checkNotMatches("abc", `(`))(t, false)
+
+	msg, _ := checkNotMatches("abbc", `b+`)
+	Contains(t, msg, "Match:\n"+dumpIndent+dumpIndent+`"bb"`)
+}
+
+func TestCheckAffixes(t *testing.T) {
+	testCheck(checkHasPrefix("abc", "ab"))(t, true)
+	testCheck(checkHasPrefix("abc", "bc"))(t, false)
+	testCheck(checkHasSuffix("abc", "bc"))(t, true)
+	testCheck(checkHasSuffix("abc", "ab"))(t, false)
+}
+
+func TestCheckEqualFold(t *testing.T) {
+	testCheck(checkEqualFold("Gopher", "gOPHER"))(t, true)
+	testCheck(checkEqualFold("Gopher", "gopherz"))(t, false)
+}
+
+func TestCheckContainsInOrder(t *testing.T) {
+	testCheck(checkContainsInOrder("abc"))(t, true)
+	testCheck(checkContainsInOrder("abcabc", "a", "c", "a"))(t, true)
+	testCheck(checkContainsInOrder("abc", "c", "a"))(t, false)
+	testCheck(checkContainsInOrder("aa", "aa", "a"))(t, false)
+
+	msg, _ := checkContainsInOrder("abcd", "b", "a")
+	Equal(
+		t,
+		msg,
+		"Expected to find substring in string after byte 2:\n"+
+			dumpIndent+"Substring:\n"+
+			dumpIndent+dumpIndent+`"a"`+"\n"+
+			dumpIndent+"String:\n"+
+			dumpIndent+dumpIndent+`"abcd"`,
+	)
+}
@@ -0,0 +1,92 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/peter-evans/patience"
+)
+
+// multilineStringMsg diffs g and e line-by-line, if they're both multi-line
+// strings of the same type. Lines are shown as-is, unless they differ in ways
+// that can't be seen, in which case they're quoted.
+func multilineStringMsg(g, e any) (string, bool) {
+	gv := reflect.ValueOf(g)
+	ev := reflect.ValueOf(e)
+
+	ok := gv.Kind() == reflect.String &&
+		ev.Kind() == reflect.String &&
+		gv.Type() == ev.Type() &&
+		(strings.Contains(gv.String(), "\n") || strings.Contains(ev.String(), "\n"))
+	if !ok {
+		return "", false
+	}
+
+	var (
+		gl    = strings.Split(gv.String(), "\n")
+		el    = strings.Split(ev.String(), "\n")
+		diffs = patience.Diff(gl, el)
+		pairs = pairChanges(diffs)
+		width = len(strconv.Itoa(max(len(gl), len(el))))
+		gi    = 0
+		ei    = 0
+	)
+
+	numbered := make([]patience.DiffLine, len(diffs))
+	for i, diff := range diffs {
+		var n int
+
+		switch diff.Type {
+		case patience.Delete:
+			gi++
+			n = gi
+		case patience.Insert:
+			ei++
+			n = ei
+		default:
+			gi++
+			ei++
+			n = gi
+		}
+
+		text := diff.Text
+		if diff.Type != patience.Equal {
+			pair, hasPair := pairs[i]
+			invisible := hasPair && invisiblyEqual(text, diffs[pair].Text)
+			if invisible || needsQuote(text) {
+				text = strconv.Quote(text)
+			}
+		}
+
+		numbered[i] = patience.DiffLine{
+			Text: fmt.Sprintf("%*d | %s", width, n, text),
+			Type: diff.Type,
+		}
+	}
+
+	b := new(strings.Builder)
+	b.WriteString("Expected strings to be equal:\n")
+	writeDiff(b, numbered, width+len(" | "), colorOutput())
+
+	return b.String(), true
+}
+
+// needsQuote determines if a line has characters that aren't visible
+func needsQuote(line string) bool {
+	if strings.TrimRightFunc(line, unicode.IsSpace) != line {
+		return true
+	}
+
+	return strings.ContainsFunc(line, func(r rune) bool {
+		return r != '\t' && !unicode.IsPrint(r)
+	})
+}
+
+// invisiblyEqual determines if a and b only differ in whitespace
+func invisiblyEqual(a, b string) bool {
+	return slices.Equal(strings.Fields(a), strings.Fields(b))
+}
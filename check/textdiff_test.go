@@ -0,0 +1,72 @@
+package check
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMultilineStringMsg(t *testing.T) {
+	_, ok := multilineStringMsg("a", "b")
+	False(t, ok)
+
+	_, ok = multilineStringMsg("a\nb", 1)
+	False(t, ok)
+
+	_, ok = multilineStringMsg("a\nb", testStringer("a\nc"))
+	False(t, ok)
+
+	_, ok = multilineStringMsg("a\nb", nil)
+	False(t, ok)
+
+	_, ok = multilineStringMsg(nil, "a\nb")
+	False(t, ok)
+
+	testCheck(checkEqual("a\nb", nil))(t, false)
+
+	msg, ok := multilineStringMsg(
+		"one\ntwo\nthree  four\nfive\x00",
+		"one\n2\nthree four\nfive",
+	)
+	True(t, ok)
+	Equal(
+		t,
+		msg,
+		"Expected strings to be equal:\n"+
+			dumpIndent+"  1 | one\n"+
+			dumpIndent+"- 2 | two\n"+
+			dumpIndent+`- 3 | "three  four"`+"\n"+
+			dumpIndent+`- 4 | "five\x00"`+"\n"+
+			dumpIndent+"+ 2 | 2\n"+
+			dumpIndent+`+ 3 | "three four"`+"\n"+
+			dumpIndent+"+ 4 | five",
+	)
+}
+
+func TestNeedsQuote(t *testing.T) {
+	False(t, needsQuote(""))
+	False(t, needsQuote("\tindented"))
+	True(t, needsQuote("trailing "))
+	True(t, needsQuote("cr\r"))
+	True(t, needsQuote("nul\x00"))
+}
+
+func TestEqualMsgMultilineString(t *testing.T) {
+	msg := equalMsg("a\nb", "a\nc")
+	Contains(t, msg, "- 2 | b")
+	Contains(t, msg, "+ 2 | c")
+}
+
+func TestMultilineStringMsgCaretGutter(t *testing.T) {
+	g := "1\n2\n3\n4\n5\n6\n7\n8\nabc"
+	e := "0\n" + strings.Replace(g, "abc", "abd", 1)
+
+	msg, ok := multilineStringMsg(g, e)
+	True(t, ok)
+	Contains(
+		t,
+		msg,
+		dumpIndent+"-  9 | abc\n"+
+			dumpIndent+"+ 10 | abd\n"+
+			dumpIndent+"         ^",
+	)
+}
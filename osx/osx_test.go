@@ -1,10 +1,11 @@
-package osx
+package osx_test
 
 import (
 	"os"
 	"testing"
 
 	"github.com/thatguystone/cog/check"
+	"github.com/thatguystone/cog/osx"
 )
 
 func TestIsTerminal(t *testing.T) {
@@ -13,7 +14,7 @@ func TestIsTerminal(t *testing.T) {
 		check.MustNil(t, err)
 		defer f.Close()
 
-		is, err := IsTerminal(f)
+		is, err := osx.IsTerminal(f)
 		check.MustNil(t, err)
 		check.False(t, is)
 	})
@@ -21,7 +22,7 @@ func TestIsTerminal(t *testing.T) {
 	t.Run("InvalidFile", func(t *testing.T) {
 		var f *os.File
 
-		_, err := IsTerminal(f)
+		_, err := osx.IsTerminal(f)
 		check.NotNil(t, err)
 	})
 }
@@ -32,7 +33,7 @@ func TestIsDevNull(t *testing.T) {
 		check.MustNil(t, err)
 		defer f.Close()
 
-		is, err := IsDevNull(f)
+		is, err := osx.IsDevNull(f)
 		check.MustNil(t, err)
 		check.True(t, is)
 	})
@@ -40,7 +41,7 @@ func TestIsDevNull(t *testing.T) {
 	t.Run("InvalidFile", func(t *testing.T) {
 		var f *os.File
 
-		_, err := IsDevNull(f)
+		_, err := osx.IsDevNull(f)
 		check.NotNil(t, err)
 	})
 }